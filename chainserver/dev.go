@@ -0,0 +1,239 @@
+package chainserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"math/big"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	// devGasLimit is the gas limit of every dev chain block.
+	devGasLimit = 30_000_000
+
+	// devRequestTimeout is the time allowed for a call to the engine.
+	devRequestTimeout = 5 * time.Second
+)
+
+// devBalance is the genesis balance of every dev account.
+var devBalance = new(big.Int).Mul(big.NewInt(1_000_000), big.NewInt(params.Ether))
+
+// DevKeys returns n deterministic dev account keys, so that the dev accounts
+// stay the same across restarts.
+func DevKeys(n int) []*ecdsa.PrivateKey {
+	keys := make([]*ecdsa.PrivateKey, 0, n)
+	for i := 0; i < n; i++ {
+		seed := crypto.Keccak256([]byte(fmt.Sprintf("trusted-engine dev account %d", i)))
+		key, err := crypto.ToECDSA(seed)
+		if err != nil {
+			panic(err)
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// DevChain is an in-process simulated chain. Blocks are mined from the block
+// filled by the engine and committed back to it, no host node is needed.
+type DevChain struct {
+	engine   consensus.Engine
+	chain    *core.BlockChain
+	coinbase common.Address
+	server   *grpc.Server
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewDevChain creates an in memory chain whose genesis funds the accounts, the
+// first account receives the block rewards.
+func NewDevChain(chainconfig *params.ChainConfig, accounts []common.Address) (*DevChain, error) {
+	db := rawdb.NewMemoryDatabase()
+	alloc := make(core.GenesisAlloc)
+	for _, addr := range accounts {
+		alloc[addr] = core.GenesisAccount{Balance: devBalance}
+	}
+	gspec := &core.Genesis{
+		Config:     chainconfig,
+		GasLimit:   devGasLimit,
+		Difficulty: big.NewInt(1),
+		Alloc:      alloc,
+	}
+	genesis, err := gspec.Commit(db)
+	if err != nil {
+		return nil, err
+	}
+	engine := ethash.NewFaker()
+	chain, err := core.NewBlockChain(db, nil, chainconfig, engine, vm.Config{}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	d := &DevChain{
+		engine: engine,
+		chain:  chain,
+		quit:   make(chan struct{}),
+	}
+	if len(accounts) > 0 {
+		d.coinbase = accounts[0]
+	}
+	log.WithField("genesis", genesis.Hash().Hex()).WithField("chainid", chainconfig.ChainID).
+		WithField("accounts", len(accounts)).Info("dev chain created")
+	return d, nil
+}
+
+// BlockChain returns the underlying chain.
+func (d *DevChain) BlockChain() *core.BlockChain {
+	return d.chain
+}
+
+// Serve serves the chain on the given address and returns the listen address.
+func (d *DevChain) Serve(addr string) (net.Addr, error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	d.server = grpc.NewServer()
+	Register(d.server, d.chain)
+	go func() {
+		if err := d.server.Serve(lis); err != nil {
+			log.WithField("err", err).Error("dev chain server stopped")
+		}
+	}()
+	log.WithField("addr", lis.Addr().String()).Info("dev chain server started")
+	return lis.Addr(), nil
+}
+
+// StartMining mines a block from the engine at the given address every period.
+func (d *DevChain) StartMining(engineAddr string, period time.Duration) error {
+	conn, err := grpc.Dial(engineAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	engine := trusted.NewTrustedServiceClient(conn)
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		defer conn.Close()
+
+		ticker := time.NewTicker(period)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				block, err := d.mineBlock(engine)
+				if err != nil {
+					log.WithField("err", err).Warn("dev chain mining failed")
+					continue
+				}
+				log.WithField("number", block.NumberU64()).WithField("hash", block.Hash().Hex()).
+					WithField("txs", len(block.Transactions())).Info("dev chain mined block")
+			case <-d.quit:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops mining and serving, and closes the chain.
+func (d *DevChain) Stop() {
+	close(d.quit)
+	d.wg.Wait()
+	if d.server != nil {
+		d.server.Stop()
+	}
+	d.chain.Stop()
+}
+
+// mineBlock builds a block on top of the current head from the transactions
+// filled by the engine, inserts it and hands it back for verification. Filled
+// transactions which fail to apply are left out.
+func (d *DevChain) mineBlock(engine trusted.TrustedServiceClient) (*types.Block, error) {
+	config := d.chain.Config()
+	parent := d.chain.CurrentBlock()
+	timestamp := uint64(time.Now().Unix())
+	if timestamp <= parent.Time() {
+		timestamp = parent.Time() + 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), devRequestTimeout)
+	defer cancel()
+	filled, err := engine.FillBlock(ctx, &trusted.FillBlockRequest{ParentHash: parent.Hash().Bytes(), Timestamp: timestamp})
+	if err != nil {
+		return nil, fmt.Errorf("fill block failed: %w", err)
+	}
+	var txs types.Transactions
+	if len(filled.SortedTxs) > 0 {
+		if err := rlp.DecodeBytes(filled.SortedTxs, &txs); err != nil {
+			return nil, fmt.Errorf("invalid filled transactions: %w", err)
+		}
+	}
+
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Coinbase:   d.coinbase,
+		Number:     new(big.Int).Add(parent.Number(), common.Big1),
+		GasLimit:   core.CalcGasLimit(parent.GasLimit(), devGasLimit),
+		Time:       timestamp,
+	}
+	header.Difficulty = d.engine.CalcDifficulty(d.chain, timestamp, parent.Header())
+	if config.IsLondon(header.Number) {
+		header.BaseFee = misc.CalcBaseFee(config, parent.Header())
+	}
+	statedb, err := d.chain.StateAt(parent.Root())
+	if err != nil {
+		return nil, err
+	}
+	var (
+		gp       = new(core.GasPool).AddGas(header.GasLimit)
+		included = make(types.Transactions, 0, len(txs))
+		receipts = make(types.Receipts, 0, len(txs))
+	)
+	for _, tx := range txs {
+		statedb.Prepare(tx.Hash(), len(included))
+		snap, gas := statedb.Snapshot(), header.GasUsed
+		receipt, err := core.ApplyTransaction(config, d.chain, &d.coinbase, gp, statedb, header, tx, &header.GasUsed, vm.Config{})
+		if err != nil {
+			log.WithField("hash", tx.Hash().Hex()).WithField("err", err).Debug("dev chain skipped transaction")
+			statedb.RevertToSnapshot(snap)
+			header.GasUsed = gas
+			continue
+		}
+		included = append(included, tx)
+		receipts = append(receipts, receipt)
+	}
+	block, err := d.engine.FinalizeAndAssemble(d.chain, header, statedb, included, nil, receipts)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := d.chain.InsertChain(types.Blocks{block}); err != nil {
+		return nil, fmt.Errorf("insert block failed: %w", err)
+	}
+
+	data, err := rlp.EncodeToBytes(block)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := engine.CommittedBlockVerify(ctx, &trusted.CommittedBlockVerifyRequest{BlockData: data}); err != nil {
+		log.WithField("err", err).Warn("committed block verify failed")
+	}
+	return block, nil
+}
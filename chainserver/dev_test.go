@@ -0,0 +1,71 @@
+package chainserver
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc"
+	"math/big"
+	"testing"
+)
+
+// testEngine fills blocks with fixed transactions and records verified blocks.
+type testEngine struct {
+	trusted.TrustedServiceClient
+	txs      types.Transactions
+	verified []*types.Block
+}
+
+func (e *testEngine) FillBlock(ctx context.Context, req *trusted.FillBlockRequest, opts ...grpc.CallOption) (*trusted.FillBlockResponse, error) {
+	data, err := rlp.EncodeToBytes(e.txs)
+	if err != nil {
+		return nil, err
+	}
+	return &trusted.FillBlockResponse{SortedTxs: data}, nil
+}
+
+func (e *testEngine) CommittedBlockVerify(ctx context.Context, req *trusted.CommittedBlockVerifyRequest, opts ...grpc.CallOption) (*trusted.CommittedBlockVerifyResponse, error) {
+	block := new(types.Block)
+	if err := rlp.DecodeBytes(req.BlockData, block); err != nil {
+		return nil, err
+	}
+	e.verified = append(e.verified, block)
+	return new(trusted.CommittedBlockVerifyResponse), nil
+}
+
+func TestDevChain_MineBlock(t *testing.T) {
+	keys := DevKeys(2)
+	if again := DevKeys(2); crypto.PubkeyToAddress(again[1].PublicKey) != crypto.PubkeyToAddress(keys[1].PublicKey) {
+		t.Fatal("dev keys not deterministic")
+	}
+	from, to := crypto.PubkeyToAddress(keys[0].PublicKey), crypto.PubkeyToAddress(keys[1].PublicKey)
+	devchain, err := NewDevChain(params.TestChainConfig, []common.Address{from, to})
+	if err != nil {
+		t.Fatalf("create dev chain failed: %v", err)
+	}
+	defer devchain.Stop()
+
+	signer := types.LatestSigner(params.TestChainConfig)
+	fee := big.NewInt(params.InitialBaseFee)
+	valid, _ := types.SignTx(types.NewTransaction(0, to, big.NewInt(1), params.TxGas, fee, nil), signer, keys[0])
+	gapped, _ := types.SignTx(types.NewTransaction(5, to, big.NewInt(1), params.TxGas, fee, nil), signer, keys[0])
+	engine := &testEngine{txs: types.Transactions{valid, gapped}}
+
+	block, err := devchain.mineBlock(engine)
+	if err != nil {
+		t.Fatalf("mine block failed: %v", err)
+	}
+	if head := devchain.BlockChain().CurrentBlock(); head.Hash() != block.Hash() {
+		t.Errorf("head mismatch: have %x, want %x", head.Hash(), block.Hash())
+	}
+	if txs := block.Transactions(); len(txs) != 1 || txs[0].Hash() != valid.Hash() {
+		t.Errorf("block transactions mismatch: have %d, want only %x", len(txs), valid.Hash())
+	}
+	if len(engine.verified) != 1 || engine.verified[0].Hash() != block.Hash() {
+		t.Errorf("mined block not verified")
+	}
+}
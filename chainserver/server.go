@@ -0,0 +1,245 @@
+// Package chainserver is a reference implementation of the host side
+// ChainService, serving the chain of a go-ethereum BlockChain to the engine.
+package chainserver
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/sirupsen/logrus"
+	"github.com/trusted-defi/trusted-engine/core/chainclient"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"math/big"
+)
+
+var log = logrus.WithField("prefix", "chainserver")
+
+// maxBlockRange is the maximum number of blocks returned by one GetBlocks call.
+const maxBlockRange = 128
+
+// chainHeadChanSize is the size of the channel buffering chain head events.
+const chainHeadChanSize = 16
+
+// features is the list of optional protocol features served.
+var features = []string{chainclient.FeatureHeaderStream, chainclient.FeatureBlockRange, chainclient.FeatureHeaderFetch}
+
+// ChainServer serves ChainService on top of a go-ethereum BlockChain.
+type ChainServer struct {
+	chain *core.BlockChain
+	trusted.UnimplementedChainServiceServer
+}
+
+// NewChainServer creates a chain server for the given chain.
+func NewChainServer(chain *core.BlockChain) *ChainServer {
+	return &ChainServer{chain: chain}
+}
+
+// Register creates a chain server for the given chain and registers it on the
+// grpc server.
+func Register(server *grpc.Server, chain *core.BlockChain) *ChainServer {
+	s := NewChainServer(chain)
+	trusted.RegisterChainServiceServer(server, s)
+	return s
+}
+
+func (s *ChainServer) ServiceReady(ctx context.Context, req *emptypb.Empty) (*trusted.ServiceReadyResponse, error) {
+	res := new(trusted.ServiceReadyResponse)
+	res.Ready = s.chain.CurrentBlock() != nil
+	return res, nil
+}
+
+func (s *ChainServer) GetChainInfo(ctx context.Context, req *trusted.ChainInfoRequest) (*trusted.ChainInfoResponse, error) {
+	chainconfig := s.chain.Config()
+	configdata, err := json.Marshal(chainconfig)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode chain config failed: %v", err)
+	}
+	res := new(trusted.ChainInfoResponse)
+	res.ProtocolVersion = chainclient.ProtocolVersion
+	res.ChainId = chainconfig.ChainID.Bytes()
+	res.GenesisHash = s.chain.Genesis().Hash().Bytes()
+	res.ChainConfig = configdata
+	res.Features = features
+	return res, nil
+}
+
+// GetBlock returns the block by hash and number, or by number only if no hash
+// is given. An empty block data is returned if the block is unknown.
+func (s *ChainServer) GetBlock(ctx context.Context, req *trusted.BlockRequest) (*trusted.BlockResponse, error) {
+	var block *types.Block
+	if len(req.BlockHash) > 0 {
+		block = s.chain.GetBlock(common.BytesToHash(req.BlockHash), req.BlockNum)
+	} else {
+		block = s.chain.GetBlockByNumber(req.BlockNum)
+	}
+	res := new(trusted.BlockResponse)
+	if block == nil {
+		return res, nil
+	}
+	data, err := rlp.EncodeToBytes(block)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode block failed: %v", err)
+	}
+	res.BlockData = data
+	return res, nil
+}
+
+// GetBlocks returns the requested block followed by up to count-1 ancestors,
+// stopping early at genesis or at the first unknown block.
+func (s *ChainServer) GetBlocks(ctx context.Context, req *trusted.BlockRangeRequest) (*trusted.BlocksResponse, error) {
+	count := req.Count
+	if count > maxBlockRange {
+		count = maxBlockRange
+	}
+	res := new(trusted.BlocksResponse)
+	hash, number := common.BytesToHash(req.BlockHash), req.BlockNum
+	for i := uint64(0); i < count; i++ {
+		block := s.chain.GetBlock(hash, number)
+		if block == nil {
+			break
+		}
+		data, err := rlp.EncodeToBytes(block)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "encode block failed: %v", err)
+		}
+		res.BlockData = append(res.BlockData, data)
+		if number == 0 {
+			break
+		}
+		hash, number = block.ParentHash(), number-1
+	}
+	return res, nil
+}
+
+// GetHeader returns the header by hash and number, or by number only if no hash
+// is given. An empty header data is returned if the header is unknown.
+func (s *ChainServer) GetHeader(ctx context.Context, req *trusted.HeaderRequest) (*trusted.HeaderResponse, error) {
+	var header *types.Header
+	if len(req.BlockHash) > 0 {
+		header = s.chain.GetHeader(common.BytesToHash(req.BlockHash), req.BlockNum)
+	} else {
+		header = s.chain.GetHeaderByNumber(req.BlockNum)
+	}
+	res := new(trusted.HeaderResponse)
+	if header == nil {
+		return res, nil
+	}
+	data, err := rlp.EncodeToBytes(header)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode header failed: %v", err)
+	}
+	res.HeaderData = data
+	return res, nil
+}
+
+func (s *ChainServer) GetBalance(ctx context.Context, req *trusted.BalanceRequest) (*trusted.BalanceResponse, error) {
+	statedb, err := s.stateAt(req.BlockNum)
+	if err != nil {
+		return nil, err
+	}
+	res := new(trusted.BalanceResponse)
+	res.Balance = statedb.GetBalance(common.BytesToAddress(req.Address)).Bytes()
+	return res, nil
+}
+
+func (s *ChainServer) GetNonce(ctx context.Context, req *trusted.NonceRequest) (*trusted.NonceResponse, error) {
+	statedb, err := s.stateAt(req.BlockNum)
+	if err != nil {
+		return nil, err
+	}
+	res := new(trusted.NonceResponse)
+	res.Nonce = statedb.GetNonce(common.BytesToAddress(req.Address))
+	return res, nil
+}
+
+func (s *ChainServer) CurrentBlock(ctx context.Context, req *trusted.CurrentBlockRequest) (*trusted.CurrentBlockResponse, error) {
+	data, err := rlp.EncodeToBytes(s.chain.CurrentBlock())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode block failed: %v", err)
+	}
+	res := new(trusted.CurrentBlockResponse)
+	res.BlockData = data
+	return res, nil
+}
+
+func (s *ChainServer) LatestHeader(ctx context.Context, req *trusted.LatestHeaderRequest) (*trusted.LatestHeaderResponse, error) {
+	header := s.chain.CurrentHeader()
+	data, err := json.Marshal(header)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode header failed: %v", err)
+	}
+	res := new(trusted.LatestHeaderResponse)
+	res.BlockNum = header.Number.Bytes()
+	res.HeaderJson = data
+	return res, nil
+}
+
+func (s *ChainServer) ChainHeadEvent(req *trusted.ChainHeadEventRequest, stream trusted.ChainService_ChainHeadEventServer) error {
+	return s.streamHeads(stream.Context(), func(block *types.Block) error {
+		data, err := rlp.EncodeToBytes(block)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&trusted.ChainHeadEventResponse{BlockData: data})
+	})
+}
+
+func (s *ChainServer) ChainHeaderEvent(req *trusted.ChainHeaderEventRequest, stream trusted.ChainService_ChainHeaderEventServer) error {
+	return s.streamHeads(stream.Context(), func(block *types.Block) error {
+		data, err := rlp.EncodeToBytes(block.Header())
+		if err != nil {
+			return err
+		}
+		return stream.Send(&trusted.ChainHeaderEventResponse{HeaderData: data})
+	})
+}
+
+// streamHeads passes every new chain head to send until the stream is closed
+// or send fails.
+func (s *ChainServer) streamHeads(ctx context.Context, send func(*types.Block) error) error {
+	ch := make(chan core.ChainHeadEvent, chainHeadChanSize)
+	sub := s.chain.SubscribeChainHeadEvent(ch)
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case ev := <-ch:
+			if err := send(ev.Block); err != nil {
+				log.WithField("err", err).Debug("chain head stream closed")
+				return err
+			}
+		case err := <-sub.Err():
+			return err
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// stateAt returns the state at the given big endian block number, or the state
+// of the current head if no number is given.
+func (s *ChainServer) stateAt(number []byte) (*state.StateDB, error) {
+	header := s.chain.CurrentHeader()
+	if len(number) > 0 {
+		num := new(big.Int).SetBytes(number)
+		if !num.IsUint64() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid block number %v", num)
+		}
+		if header = s.chain.GetHeaderByNumber(num.Uint64()); header == nil {
+			return nil, status.Errorf(codes.NotFound, "unknown block %v", num)
+		}
+	}
+	statedb, err := s.chain.StateAt(header.Root)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "state of block %v unavailable: %v", header.Number, err)
+	}
+	return statedb, nil
+}
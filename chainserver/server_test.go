@@ -0,0 +1,232 @@
+package chainserver
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/trusted-defi/trusted-engine/config"
+	"github.com/trusted-defi/trusted-engine/core/chainclient"
+	"google.golang.org/grpc"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+)
+
+var (
+	testKey, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	testAddr    = crypto.PubkeyToAddress(testKey.PublicKey)
+	testBalance = big.NewInt(1e18)
+	testRecv    = common.HexToAddress("0x5c27dd97ddf34588006740a2c2665f5dba3b76c8")
+)
+
+// testChain is an in memory chain with one value transfer per block.
+type testChain struct {
+	db    ethdb.Database
+	chain *core.BlockChain
+}
+
+func newTestChain(t *testing.T, n int) *testChain {
+	db := rawdb.NewMemoryDatabase()
+	gspec := &core.Genesis{
+		Config: params.TestChainConfig,
+		Alloc:  core.GenesisAlloc{testAddr: {Balance: testBalance}},
+	}
+	genesis := gspec.MustCommit(db)
+	chain, err := core.NewBlockChain(db, nil, gspec.Config, ethash.NewFaker(), vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("create chain failed: %v", err)
+	}
+	tc := &testChain{db: db, chain: chain}
+	tc.extend(t, genesis, n)
+	return tc
+}
+
+// extend generates n blocks on top of parent and inserts them.
+func (tc *testChain) extend(t *testing.T, parent *types.Block, n int) []*types.Block {
+	signer := types.LatestSigner(tc.chain.Config())
+	nonce := tc.nonce(t, parent)
+	blocks, _ := core.GenerateChain(tc.chain.Config(), parent, ethash.NewFaker(), tc.db, n, func(i int, gen *core.BlockGen) {
+		tx, err := types.SignTx(types.NewTransaction(nonce+uint64(i), testRecv, big.NewInt(1), params.TxGas, gen.BaseFee(), nil), signer, testKey)
+		if err != nil {
+			t.Fatalf("sign tx failed: %v", err)
+		}
+		gen.AddTx(tx)
+	})
+	if _, err := tc.chain.InsertChain(blocks); err != nil {
+		t.Fatalf("insert chain failed: %v", err)
+	}
+	return blocks
+}
+
+func (tc *testChain) nonce(t *testing.T, block *types.Block) uint64 {
+	statedb, err := tc.chain.StateAt(block.Root())
+	if err != nil {
+		t.Fatalf("state unavailable: %v", err)
+	}
+	return statedb.GetNonce(testAddr)
+}
+
+// newTestClient serves the chain on a loopback port and connects a chain client.
+func newTestClient(t *testing.T, chain *core.BlockChain) *chainclient.ChainClient {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen failed: %v", err)
+	}
+	server := grpc.NewServer()
+	Register(server, chain)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	client, err := chainclient.NewChainClient(config.NodeConfig{
+		ChainServer: lis.Addr().String(),
+		ChainID:     chain.Config().ChainID.Uint64(),
+	})
+	if err != nil {
+		t.Fatalf("new chain client failed: %v", err)
+	}
+	return client
+}
+
+func TestChainServer_ChainInfo(t *testing.T) {
+	tc := newTestChain(t, 1)
+	defer tc.chain.Stop()
+	client := newTestClient(t, tc.chain)
+
+	info := client.ChainInfo()
+	if info == nil {
+		t.Fatal("chain info not negotiated")
+	}
+	if info.ChainID.Cmp(params.TestChainConfig.ChainID) != 0 {
+		t.Errorf("chain id mismatch: have %v, want %v", info.ChainID, params.TestChainConfig.ChainID)
+	}
+	if info.GenesisHash != tc.chain.Genesis().Hash() {
+		t.Errorf("genesis mismatch: have %x, want %x", info.GenesisHash, tc.chain.Genesis().Hash())
+	}
+	if info.ChainConfig == nil || info.ChainConfig.LondonBlock == nil {
+		t.Errorf("chain config not transferred: %v", info.ChainConfig)
+	}
+	for _, feature := range features {
+		if !info.Supports(feature) {
+			t.Errorf("feature %s not announced", feature)
+		}
+	}
+}
+
+func TestChainServer_Blocks(t *testing.T) {
+	tc := newTestChain(t, 8)
+	defer tc.chain.Stop()
+	client := newTestClient(t, tc.chain)
+
+	head := tc.chain.CurrentBlock()
+	current, err := client.CurrentBlock()
+	if err != nil {
+		t.Fatalf("current block failed: %v", err)
+	}
+	if current.Hash() != head.Hash() {
+		t.Errorf("current block mismatch: have %x, want %x", current.Hash(), head.Hash())
+	}
+	header, err := client.CurrentHeader()
+	if err != nil {
+		t.Fatalf("current header failed: %v", err)
+	}
+	if header.Hash() != head.Hash() {
+		t.Errorf("current header mismatch: have %x, want %x", header.Hash(), head.Hash())
+	}
+	want := tc.chain.GetBlockByNumber(3)
+	if block := client.GetBlock(want.Hash(), 3); block == nil || block.Hash() != want.Hash() {
+		t.Errorf("block 3 mismatch: have %v, want %x", block, want.Hash())
+	}
+	if block := client.GetBlock(common.Hash{1}, 3); block != nil && block.Hash() == want.Hash() {
+		t.Errorf("unknown block returned")
+	}
+
+	blocks := client.GetBlocks(head.Hash(), head.NumberU64(), 4)
+	if len(blocks) != 4 {
+		t.Fatalf("block range length mismatch: have %d, want 4", len(blocks))
+	}
+	for i, block := range blocks {
+		if block.NumberU64() != head.NumberU64()-uint64(i) {
+			t.Errorf("block %d number mismatch: have %d, want %d", i, block.NumberU64(), head.NumberU64()-uint64(i))
+		}
+	}
+	if blocks := client.GetBlocks(want.Hash(), 3, 10); len(blocks) != 4 {
+		t.Errorf("range past genesis length mismatch: have %d, want 4", len(blocks))
+	}
+}
+
+func TestChainServer_Header(t *testing.T) {
+	tc := newTestChain(t, 8)
+	defer tc.chain.Stop()
+	client := newTestClient(t, tc.chain)
+
+	want := tc.chain.GetHeaderByNumber(5)
+	if header := client.GetHeader(want.Hash(), 5); header == nil || header.Hash() != want.Hash() {
+		t.Errorf("header 5 mismatch: have %v, want %x", header, want.Hash())
+	}
+	if header := client.GetHeader(common.Hash{1}, 5); header != nil {
+		t.Errorf("unknown header returned: %x", header.Hash())
+	}
+}
+
+func TestChainServer_State(t *testing.T) {
+	tc := newTestChain(t, 5)
+	defer tc.chain.Stop()
+	client := newTestClient(t, tc.chain)
+
+	if nonce := client.NonceAt(testAddr); nonce != 5 {
+		t.Errorf("nonce mismatch: have %d, want 5", nonce)
+	}
+	if nonce := client.NonceAtHeight(testAddr, big.NewInt(2)); nonce != 2 {
+		t.Errorf("nonce at 2 mismatch: have %d, want 2", nonce)
+	}
+	if balance := client.GetBalance(testRecv); balance.Cmp(big.NewInt(5)) != 0 {
+		t.Errorf("balance mismatch: have %v, want 5", balance)
+	}
+}
+
+func TestChainServer_HeadEvents(t *testing.T) {
+	tc := newTestChain(t, 2)
+	defer tc.chain.Stop()
+	client := newTestClient(t, tc.chain)
+
+	heads := make(chan core.ChainHeadEvent, 4)
+	headSub := client.SubscribeChainHeadEvent(heads)
+	defer headSub.Unsubscribe()
+	headers := make(chan chainclient.ChainHeaderEvent, 4)
+	headerSub := client.SubscribeChainHeaderEvent(headers)
+	defer headerSub.Unsubscribe()
+
+	// The head stream is opened asynchronously, keep adding blocks until the
+	// client has seen one.
+	inserted := make(map[common.Hash]bool)
+	timeout := time.After(5 * time.Second)
+	for {
+		blocks := tc.extend(t, tc.chain.CurrentBlock(), 1)
+		inserted[blocks[0].Hash()] = true
+		select {
+		case ev := <-heads:
+			if !inserted[ev.Block.Hash()] {
+				t.Errorf("unknown head %x", ev.Block.Hash())
+			}
+			select {
+			case ev := <-headers:
+				if !inserted[ev.Header.Hash()] {
+					t.Errorf("unknown header %x", ev.Header.Hash())
+				}
+			case <-time.After(time.Second):
+				t.Error("no header event")
+			}
+			return
+		case <-time.After(100 * time.Millisecond):
+		case <-timeout:
+			t.Fatal("no head event")
+		}
+	}
+}
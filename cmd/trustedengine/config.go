@@ -0,0 +1,255 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"github.com/naoina/toml"
+	"github.com/trusted-defi/trusted-engine/config"
+	"github.com/trusted-defi/trusted-engine/core/mempool"
+	"github.com/trusted-defi/trusted-engine/log"
+	"github.com/trusted-defi/trusted-engine/node"
+	"github.com/urfave/cli/v2"
+	"os"
+	"path/filepath"
+	"reflect"
+)
+
+// configFileName is the config file looked up in the node dir when no config
+// file is given.
+const configFileName = "config.toml"
+
+var dumpConfigCommand = &cli.Command{
+	Name:        "dumpconfig",
+	Usage:       "Show the effective configuration values",
+	ArgsUsage:   "[dumpfile]",
+	Flags:       append(append([]cli.Flag{}, nodeFlags...), txPoolFlags...),
+	Description: `The dumpconfig command shows the configuration loaded from the config file, the environment and the flags, in the config file format.`,
+	Action:      dumpConfig,
+}
+
+// These settings ensure that TOML keys use the same names as Go struct fields.
+var tomlSettings = toml.Config{
+	NormFieldName: func(rt reflect.Type, key string) string {
+		return key
+	},
+	FieldToKey: func(rt reflect.Type, field string) string {
+		return field
+	},
+	MissingField: func(rt reflect.Type, field string) error {
+		return fmt.Errorf("field '%s' is not defined in %s", field, rt.String())
+	},
+}
+
+// engineConfig is the complete configuration of the engine.
+type engineConfig struct {
+	Node   config.NodeConfig
+	TxPool mempool.TxPoolConfig
+}
+
+func loadConfig(file string, cfg *engineConfig) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	err = tomlSettings.NewDecoder(bufio.NewReader(f)).Decode(cfg)
+	// Add file name to errors that have a line number.
+	if _, ok := err.(*toml.LineError); ok {
+		err = errors.New(file + ", " + err.Error())
+	}
+	return err
+}
+
+// makeConfig layers the config file, the environment and the flags on top of
+// the defaults, and sanitizes the result.
+func makeConfig(ctx *cli.Context) (engineConfig, error) {
+	cfg := engineConfig{
+		Node:   config.DefaultNodeConfig,
+		TxPool: mempool.DefaultTxPoolConfig,
+	}
+	cfg.Node.NodeDir = ctx.String(nodeDirFlag.Name)
+
+	// The config file is optional unless given explicitly
+	file := ctx.String(configFileFlag.Name)
+	if file == "" {
+		if path := filepath.Join(cfg.Node.NodeDir, configFileName); fileExists(path) {
+			file = path
+		}
+	}
+	if file != "" {
+		if err := loadConfig(file, &cfg); err != nil {
+			return cfg, err
+		}
+	}
+	applyNodeFlags(ctx, &cfg.Node)
+	applyTxPoolFlags(ctx, &cfg.TxPool)
+
+	cfg.TxPool = cfg.TxPool.Sanitize()
+	return cfg, nil
+}
+
+func applyNodeFlags(ctx *cli.Context, cfg *config.NodeConfig) {
+	cfg.Generate = ctx.Bool(generateFlag.Name)
+	cfg.GivenPrivate = ctx.String(privateFlag.Name)
+	if ctx.IsSet(nodeDirFlag.Name) {
+		cfg.NodeDir = ctx.String(nodeDirFlag.Name)
+	}
+	if ctx.IsSet(grpcPortFlag.Name) {
+		cfg.GrpcPort = ctx.Int(grpcPortFlag.Name)
+	}
+	if ctx.IsSet(httpPortFlag.Name) {
+		cfg.HTTPPort = ctx.Int(httpPortFlag.Name)
+	}
+	if ctx.IsSet(rpcPortFlag.Name) {
+		cfg.RPCPort = ctx.Int(rpcPortFlag.Name)
+	}
+	if ctx.IsSet(rpcOriginsFlag.Name) {
+		cfg.RPCOrigins = ctx.StringSlice(rpcOriginsFlag.Name)
+	}
+	if ctx.IsSet(chainServerFlag.Name) {
+		cfg.ChainServer = ctx.String(chainServerFlag.Name)
+	}
+	if ctx.IsSet(chainIDFlag.Name) {
+		cfg.ChainID = ctx.Uint64(chainIDFlag.Name)
+	}
+	if ctx.IsSet(chainTLSFlag.Name) {
+		cfg.ChainTLS.Enabled = ctx.Bool(chainTLSFlag.Name)
+	}
+	if ctx.IsSet(chainTLSCAFlag.Name) {
+		cfg.ChainTLS.CA = ctx.String(chainTLSCAFlag.Name)
+	}
+	if ctx.IsSet(chainTLSServerCertFlag.Name) {
+		cfg.ChainTLS.ServerCert = ctx.String(chainTLSServerCertFlag.Name)
+	}
+	if ctx.IsSet(chainTLSServerNameFlag.Name) {
+		cfg.ChainTLS.ServerName = ctx.String(chainTLSServerNameFlag.Name)
+	}
+	if ctx.IsSet(chainTLSCertFlag.Name) {
+		cfg.ChainTLS.Cert = ctx.String(chainTLSCertFlag.Name)
+	}
+	if ctx.IsSet(chainTLSKeyFlag.Name) {
+		cfg.ChainTLS.Key = ctx.String(chainTLSKeyFlag.Name)
+	}
+	if ctx.IsSet(networkFlag.Name) {
+		cfg.Network = ctx.String(networkFlag.Name)
+	}
+	if ctx.IsSet(genesisFlag.Name) {
+		cfg.GenesisFile = ctx.String(genesisFlag.Name)
+	}
+	if ctx.IsSet(devFlag.Name) {
+		cfg.Dev = ctx.Bool(devFlag.Name)
+	}
+	if ctx.IsSet(devPeriodFlag.Name) {
+		cfg.DevPeriod = ctx.Duration(devPeriodFlag.Name)
+	}
+	if ctx.IsSet(devAccountsFlag.Name) {
+		cfg.DevAccounts = ctx.Int(devAccountsFlag.Name)
+	}
+	if ctx.IsSet(shutdownTimeoutFlag.Name) {
+		cfg.ShutdownTimeout = ctx.Duration(shutdownTimeoutFlag.Name)
+	}
+	if ctx.IsSet(tlsCertFlag.Name) {
+		cfg.TLS.Cert = ctx.String(tlsCertFlag.Name)
+	}
+	if ctx.IsSet(tlsKeyFlag.Name) {
+		cfg.TLS.Key = ctx.String(tlsKeyFlag.Name)
+	}
+	if ctx.IsSet(tlsClientCAFlag.Name) {
+		cfg.TLS.ClientCA = ctx.String(tlsClientCAFlag.Name)
+	}
+	if ctx.IsSet(tlsAttestedFlag.Name) {
+		cfg.TLS.Attested = ctx.Bool(tlsAttestedFlag.Name)
+	}
+	if ctx.IsSet(authFlag.Name) {
+		cfg.Auth.Enabled = ctx.Bool(authFlag.Name)
+	}
+	if ctx.IsSet(authAuditFlag.Name) {
+		cfg.Auth.Audit = ctx.Bool(authAuditFlag.Name)
+	}
+}
+
+func applyTxPoolFlags(ctx *cli.Context, cfg *mempool.TxPoolConfig) {
+	if ctx.IsSet(txPoolNoLocalsFlag.Name) {
+		cfg.NoLocals = ctx.Bool(txPoolNoLocalsFlag.Name)
+	}
+	if ctx.IsSet(txPoolJournalFlag.Name) {
+		cfg.Journal = ctx.String(txPoolJournalFlag.Name)
+	}
+	if ctx.IsSet(txPoolRejournalFlag.Name) {
+		cfg.Rejournal = ctx.Duration(txPoolRejournalFlag.Name)
+	}
+	if ctx.IsSet(txPoolSnapshotFlag.Name) {
+		cfg.Snapshot = ctx.String(txPoolSnapshotFlag.Name)
+	}
+	if ctx.IsSet(txPoolPriceLimitFlag.Name) {
+		cfg.PriceLimit = ctx.Uint64(txPoolPriceLimitFlag.Name)
+	}
+	if ctx.IsSet(txPoolPriceBumpFlag.Name) {
+		cfg.PriceBump = ctx.Uint64(txPoolPriceBumpFlag.Name)
+	}
+	if ctx.IsSet(txPoolAccountSlotsFlag.Name) {
+		cfg.AccountSlots = ctx.Uint64(txPoolAccountSlotsFlag.Name)
+	}
+	if ctx.IsSet(txPoolGlobalSlotsFlag.Name) {
+		cfg.GlobalSlots = ctx.Uint64(txPoolGlobalSlotsFlag.Name)
+	}
+	if ctx.IsSet(txPoolAccountQueueFlag.Name) {
+		cfg.AccountQueue = ctx.Uint64(txPoolAccountQueueFlag.Name)
+	}
+	if ctx.IsSet(txPoolGlobalQueueFlag.Name) {
+		cfg.GlobalQueue = ctx.Uint64(txPoolGlobalQueueFlag.Name)
+	}
+	if ctx.IsSet(txPoolLifetimeFlag.Name) {
+		cfg.Lifetime = ctx.Duration(txPoolLifetimeFlag.Name)
+	}
+	if ctx.IsSet(txPoolMaxReorgDepthFlag.Name) {
+		cfg.MaxReorgDepth = ctx.Uint64(txPoolMaxReorgDepthFlag.Name)
+	}
+	if ctx.IsSet(txPoolHistorySlotsFlag.Name) {
+		cfg.HistorySlots = ctx.Uint64(txPoolHistorySlotsFlag.Name)
+	}
+	if ctx.IsSet(txPoolHistoryLifetimeFlag.Name) {
+		cfg.HistoryLifetime = ctx.Duration(txPoolHistoryLifetimeFlag.Name)
+	}
+}
+
+// reloadConfig reads the config again and applies the pool settings, the node
+// settings need a restart.
+func reloadConfig(ctx *cli.Context, n *node.Node) {
+	cfg, err := makeConfig(ctx)
+	if err != nil {
+		log.WithField("err", err).Error("reload config failed")
+		return
+	}
+	n.SetTxPoolConfig(cfg.TxPool)
+	log.Info("config reloaded")
+}
+
+// dumpConfig is the dumpconfig command.
+func dumpConfig(ctx *cli.Context) error {
+	cfg, err := makeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	out, err := tomlSettings.Marshal(&cfg)
+	if err != nil {
+		return err
+	}
+	dump := os.Stdout
+	if ctx.NArg() > 0 {
+		dump, err = os.OpenFile(ctx.Args().Get(0), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+		if err != nil {
+			return err
+		}
+		defer dump.Close()
+	}
+	dump.Write(out)
+	return nil
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/trusted-defi/trusted-engine/config"
+	"github.com/trusted-defi/trusted-engine/core/mempool"
+	"github.com/urfave/cli/v2"
+)
+
+// freshFlags copies the flags, as applying them keeps the values taken from
+// the environment in the flag.
+func freshFlags(flags []cli.Flag) []cli.Flag {
+	fresh := make([]cli.Flag, len(flags))
+	for i, f := range flags {
+		v := reflect.New(reflect.TypeOf(f).Elem())
+		v.Elem().Set(reflect.ValueOf(f).Elem())
+		fresh[i] = v.Interface().(cli.Flag)
+	}
+	return fresh
+}
+
+// runMakeConfig runs makeConfig on the command line arguments.
+func runMakeConfig(t *testing.T, args ...string) (engineConfig, error) {
+	var (
+		cfg engineConfig
+		err error
+	)
+	app := &cli.App{
+		Flags: freshFlags(append(append([]cli.Flag{}, nodeFlags...), txPoolFlags...)),
+		Action: func(ctx *cli.Context) error {
+			cfg, err = makeConfig(ctx)
+			return nil
+		},
+	}
+	if runErr := app.Run(append([]string{"trustedengine"}, args...)); runErr != nil {
+		t.Fatalf("failed to run %v: %v", args, runErr)
+	}
+	return cfg, err
+}
+
+// Tests that the environment overrides the config file and flags override
+// both, and that invalid pool values are sanitized.
+func TestMakeConfig(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "engine.toml")
+	data := "[Node]\nGrpcPort = 4000\nChainServer = \"file:3801\"\n\n[TxPool]\nGlobalSlots = 100\nAccountQueue = 8\n"
+	if err := os.WriteFile(file, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+	// A config file in the node dir is used without --config
+	nodedir := filepath.Join(dir, "node")
+	if err := os.MkdirAll(nodedir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(nodedir, configFileName), []byte("[Node]\nGrpcPort = 4100\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	invalid := filepath.Join(dir, "invalid.toml")
+	if err := os.WriteFile(invalid, []byte("[TxPool]\nPriceLimit = 0\nPriceBump = 0\nRejournal = 1\nGlobalQueue = 0\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		args  []string
+		env   map[string]string
+		check func(cfg engineConfig) bool
+	}{
+		{
+			name: "defaults",
+			check: func(cfg engineConfig) bool {
+				return reflect.DeepEqual(cfg.TxPool, mempool.DefaultTxPoolConfig) &&
+					cfg.Node.GrpcPort == config.DefaultNodeConfig.GrpcPort && cfg.Node.NodeDir == config.DefaultNodeConfig.NodeDir
+			},
+		},
+		{
+			name: "file",
+			args: []string{"--config", file},
+			check: func(cfg engineConfig) bool {
+				return cfg.Node.GrpcPort == 4000 && cfg.Node.ChainServer == "file:3801" &&
+					cfg.TxPool.GlobalSlots == 100 && cfg.TxPool.AccountQueue == 8 &&
+					cfg.TxPool.GlobalQueue == mempool.DefaultTxPoolConfig.GlobalQueue
+			},
+		},
+		{
+			name: "node dir file",
+			args: []string{"--nodedir", nodedir},
+			check: func(cfg engineConfig) bool {
+				return cfg.Node.GrpcPort == 4100 && cfg.Node.NodeDir == nodedir
+			},
+		},
+		{
+			name: "env over file",
+			args: []string{"--config", file},
+			env:  map[string]string{"TRUSTED_GRPC_PORT": "5000", "TRUSTED_TXPOOL_GLOBALSLOTS": "200", "TRUSTED_TXPOOL_LIFETIME": "2m"},
+			check: func(cfg engineConfig) bool {
+				return cfg.Node.GrpcPort == 5000 && cfg.Node.ChainServer == "file:3801" &&
+					cfg.TxPool.GlobalSlots == 200 && cfg.TxPool.AccountQueue == 8 && cfg.TxPool.Lifetime == 2*time.Minute
+			},
+		},
+		{
+			name: "env config file",
+			env:  map[string]string{"TRUSTED_CONFIG": file},
+			check: func(cfg engineConfig) bool {
+				return cfg.Node.GrpcPort == 4000 && cfg.TxPool.GlobalSlots == 100
+			},
+		},
+		{
+			name: "flags over env and file",
+			args: []string{"--config", file, "--grpc-port", "6000", "--txpool.globalslots", "300", "--chain-server", "flag:3801"},
+			env:  map[string]string{"TRUSTED_GRPC_PORT": "5000", "TRUSTED_TXPOOL_GLOBALSLOTS": "200", "TRUSTED_TXPOOL_ACCOUNTQUEUE": "16"},
+			check: func(cfg engineConfig) bool {
+				return cfg.Node.GrpcPort == 6000 && cfg.Node.ChainServer == "flag:3801" &&
+					cfg.TxPool.GlobalSlots == 300 && cfg.TxPool.AccountQueue == 16
+			},
+		},
+		{
+			name: "sanitized file",
+			args: []string{"--config", invalid},
+			check: func(cfg engineConfig) bool {
+				def := mempool.DefaultTxPoolConfig
+				return cfg.TxPool.PriceLimit == def.PriceLimit && cfg.TxPool.PriceBump == def.PriceBump &&
+					cfg.TxPool.Rejournal == time.Second && cfg.TxPool.GlobalQueue == def.GlobalQueue
+			},
+		},
+		{
+			name: "sanitized flags",
+			args: []string{"--txpool.pricelimit", "0", "--txpool.accountslots", "0", "--txpool.historyslots", "0"},
+			env:  map[string]string{"TRUSTED_TXPOOL_LIFETIME": "0s"},
+			check: func(cfg engineConfig) bool {
+				def := mempool.DefaultTxPoolConfig
+				return cfg.TxPool.PriceLimit == def.PriceLimit && cfg.TxPool.AccountSlots == def.AccountSlots &&
+					cfg.TxPool.HistorySlots == def.HistorySlots && cfg.TxPool.Lifetime == def.Lifetime
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			cfg, err := runMakeConfig(t, tt.args...)
+			if err != nil {
+				t.Fatalf("failed to make config: %v", err)
+			}
+			if !tt.check(cfg) {
+				t.Errorf("config mismatch: node %+v, pool %+v", cfg.Node, cfg.TxPool)
+			}
+		})
+	}
+}
+
+func TestMakeConfigInvalid(t *testing.T) {
+	dir := t.TempDir()
+	unknown := filepath.Join(dir, "unknown.toml")
+	if err := os.WriteFile(unknown, []byte("[TxPool]\nGlobalSlot = 1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range []string{filepath.Join(dir, "missing.toml"), unknown} {
+		if _, err := runMakeConfig(t, "--config", file); err == nil {
+			t.Errorf("config file %s accepted", filepath.Base(file))
+		}
+	}
+}
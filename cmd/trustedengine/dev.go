@@ -0,0 +1,42 @@
+package main
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/trusted-defi/trusted-engine/chainserver"
+	"github.com/trusted-defi/trusted-engine/config"
+	"github.com/trusted-defi/trusted-engine/log"
+)
+
+// startDevChain starts the simulated chain on the chain server address and
+// points the node config to it.
+func startDevChain(nodeconfig *config.NodeConfig, accounts int) (*chainserver.DevChain, error) {
+	chainconfig, err := nodeconfig.ChainConfig()
+	if err != nil {
+		return nil, err
+	}
+	if chainconfig == nil {
+		if chainconfig, err = config.NetworkChainConfig("private"); err != nil {
+			return nil, err
+		}
+	}
+	keys := chainserver.DevKeys(accounts)
+	addrs := make([]common.Address, 0, len(keys))
+	for _, key := range keys {
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		addrs = append(addrs, addr)
+		log.WithField("address", addr.Hex()).WithField("key", common.Bytes2Hex(crypto.FromECDSA(key))).Info("dev account")
+	}
+	devchain, err := chainserver.NewDevChain(chainconfig, addrs)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := devchain.Serve(nodeconfig.ChainServer)
+	if err != nil {
+		devchain.Stop()
+		return nil, err
+	}
+	nodeconfig.ChainServer = addr.String()
+	nodeconfig.ChainID = chainconfig.ChainID.Uint64()
+	return devchain, nil
+}
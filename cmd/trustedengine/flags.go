@@ -0,0 +1,299 @@
+package main
+
+import (
+	"github.com/trusted-defi/trusted-engine/config"
+	"github.com/trusted-defi/trusted-engine/core/mempool"
+	"github.com/urfave/cli/v2"
+	"strings"
+)
+
+// envPrefix prefixes the environment variables which can be used instead of
+// the flags, e.g. TRUSTED_GRPC_PORT for --grpc-port.
+const envPrefix = "TRUSTED_"
+
+// envVars returns the environment variable of the flag.
+func envVars(name string) []string {
+	return []string{envPrefix + strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(name))}
+}
+
+var (
+	configFileFlag = &cli.StringFlag{
+		Name:    "config",
+		Usage:   "TOML config file, default config.toml in the node dir if it exists",
+		EnvVars: envVars("config"),
+	}
+	generateFlag = &cli.BoolFlag{
+		Name:  "generate",
+		Usage: "generate test secretdb",
+	}
+	privateFlag = &cli.StringFlag{
+		Name:    "private",
+		Usage:   "start with given privatekey",
+		EnvVars: envVars("private"),
+	}
+	grpcPortFlag = &cli.IntFlag{
+		Name:    "grpc-port",
+		Value:   config.DefaultNodeConfig.GrpcPort,
+		Usage:   "service port",
+		EnvVars: envVars("grpc-port"),
+	}
+	httpPortFlag = &cli.IntFlag{
+		Name:    "http-port",
+		Usage:   "REST/JSON gateway port, 0 to disable",
+		EnvVars: envVars("http-port"),
+	}
+	rpcPortFlag = &cli.IntFlag{
+		Name:    "rpc-port",
+		Usage:   "eth JSON-RPC port over http and websocket, 0 to disable",
+		EnvVars: envVars("rpc-port"),
+	}
+	rpcOriginsFlag = &cli.StringSliceFlag{
+		Name:    "rpc-origins",
+		Usage:   "origins allowed to open JSON-RPC websockets (\"*\" for any), localhost if not given",
+		EnvVars: envVars("rpc-origins"),
+	}
+	chainServerFlag = &cli.StringFlag{
+		Name:    "chain-server",
+		Value:   config.DefaultNodeConfig.ChainServer,
+		Usage:   "chain server grpc address, or http(s)/ws(s) url of an eth json-rpc endpoint",
+		EnvVars: envVars("chain-server"),
+	}
+	chainIDFlag = &cli.Uint64Flag{
+		Name:    "chain-id",
+		Value:   config.DefaultNodeConfig.ChainID,
+		Usage:   "expected chain id of chain server, 0 to accept any, must agree with --network or --genesis",
+		EnvVars: envVars("chain-id"),
+	}
+	chainTLSFlag = &cli.BoolFlag{
+		Name:    "chain-tls",
+		Usage:   "connect to chain server over TLS, verified by the system roots unless a CA or certificate is given",
+		EnvVars: envVars("chain-tls"),
+	}
+	chainTLSCAFlag = &cli.StringFlag{
+		Name:    "chain-tls.ca",
+		Usage:   "PEM file of the CAs verifying the chain server",
+		EnvVars: envVars("chain-tls.ca"),
+	}
+	chainTLSServerCertFlag = &cli.StringFlag{
+		Name:    "chain-tls.servercert",
+		Usage:   "PEM file of the pinned chain server certificates",
+		EnvVars: envVars("chain-tls.servercert"),
+	}
+	chainTLSServerNameFlag = &cli.StringFlag{
+		Name:    "chain-tls.servername",
+		Usage:   "expected name in the chain server certificate, default the address host",
+		EnvVars: envVars("chain-tls.servername"),
+	}
+	chainTLSCertFlag = &cli.StringFlag{
+		Name:    "chain-tls.cert",
+		Usage:   "PEM client certificate file presented to chain server",
+		EnvVars: envVars("chain-tls.cert"),
+	}
+	chainTLSKeyFlag = &cli.StringFlag{
+		Name:    "chain-tls.key",
+		Usage:   "PEM key file of the client certificate",
+		EnvVars: envVars("chain-tls.key"),
+	}
+	networkFlag = &cli.StringFlag{
+		Name:    "network",
+		Usage:   "network preset of the chain config (" + strings.Join(config.Networks(), ", ") + "), default from chain server",
+		EnvVars: envVars("network"),
+	}
+	genesisFlag = &cli.StringFlag{
+		Name:    "genesis",
+		Usage:   "genesis or chain config file, overrides network",
+		EnvVars: envVars("genesis"),
+	}
+	devFlag = &cli.BoolFlag{
+		Name:    "dev",
+		Usage:   "run with an in-process simulated chain, without host node and sealing",
+		EnvVars: envVars("dev"),
+	}
+	devPeriodFlag = &cli.DurationFlag{
+		Name:    "dev.period",
+		Value:   config.DefaultNodeConfig.DevPeriod,
+		Usage:   "block period of the simulated chain",
+		EnvVars: envVars("dev.period"),
+	}
+	devAccountsFlag = &cli.IntFlag{
+		Name:    "dev.accounts",
+		Value:   config.DefaultNodeConfig.DevAccounts,
+		Usage:   "number of pre-funded accounts of the simulated chain",
+		EnvVars: envVars("dev.accounts"),
+	}
+	shutdownTimeoutFlag = &cli.DurationFlag{
+		Name:    "shutdown-timeout",
+		Value:   config.DefaultNodeConfig.ShutdownTimeout,
+		Usage:   "time limit for draining requests and stopping the node on SIGINT or SIGTERM",
+		EnvVars: envVars("shutdown-timeout"),
+	}
+	nodeDirFlag = &cli.StringFlag{
+		Name:    "nodedir",
+		Value:   config.DefaultNodeConfig.NodeDir,
+		Usage:   "node data dir",
+		EnvVars: envVars("nodedir"),
+	}
+	tlsCertFlag = &cli.StringFlag{
+		Name:    "tls.cert",
+		Usage:   "PEM certificate file to serve over TLS",
+		EnvVars: envVars("tls.cert"),
+	}
+	tlsKeyFlag = &cli.StringFlag{
+		Name:    "tls.key",
+		Usage:   "PEM key file of the TLS certificate",
+		EnvVars: envVars("tls.key"),
+	}
+	tlsClientCAFlag = &cli.StringFlag{
+		Name:    "tls.clientca",
+		Usage:   "PEM file of the CAs verifying client certificates",
+		EnvVars: envVars("tls.clientca"),
+	}
+	tlsAttestedFlag = &cli.BoolFlag{
+		Name:    "tls.attested",
+		Usage:   "serve over TLS with an enclave generated certificate embedding an attestation report",
+		EnvVars: envVars("tls.attested"),
+	}
+	authFlag = &cli.BoolFlag{
+		Name:    "auth",
+		Usage:   "check the roles of callers, tokens and certificates are configured in the config file",
+		EnvVars: envVars("auth"),
+	}
+	authAuditFlag = &cli.BoolFlag{
+		Name:    "auth.audit",
+		Usage:   "log every allowed call with its caller, not only the denied ones",
+		EnvVars: envVars("auth.audit"),
+	}
+
+	txPoolNoLocalsFlag = &cli.BoolFlag{
+		Name:    "txpool.nolocals",
+		Usage:   "disable price exemptions for locally submitted transactions",
+		EnvVars: envVars("txpool.nolocals"),
+	}
+	txPoolJournalFlag = &cli.StringFlag{
+		Name:    "txpool.journal",
+		Value:   mempool.DefaultTxPoolConfig.Journal,
+		Usage:   "disk journal for local transactions to survive node restarts, relative to the node dir",
+		EnvVars: envVars("txpool.journal"),
+	}
+	txPoolSnapshotFlag = &cli.StringFlag{
+		Name:    "txpool.snapshot",
+		Value:   mempool.DefaultTxPoolConfig.Snapshot,
+		Usage:   "disk snapshot of remote transactions written on shutdown and loaded on start, relative to the node dir",
+		EnvVars: envVars("txpool.snapshot"),
+	}
+	txPoolRejournalFlag = &cli.DurationFlag{
+		Name:    "txpool.rejournal",
+		Value:   mempool.DefaultTxPoolConfig.Rejournal,
+		Usage:   "time interval to regenerate the local transaction journal",
+		EnvVars: envVars("txpool.rejournal"),
+	}
+	txPoolPriceLimitFlag = &cli.Uint64Flag{
+		Name:    "txpool.pricelimit",
+		Value:   mempool.DefaultTxPoolConfig.PriceLimit,
+		Usage:   "minimum gas price limit to enforce for acceptance into the pool",
+		EnvVars: envVars("txpool.pricelimit"),
+	}
+	txPoolPriceBumpFlag = &cli.Uint64Flag{
+		Name:    "txpool.pricebump",
+		Value:   mempool.DefaultTxPoolConfig.PriceBump,
+		Usage:   "price bump percentage to replace an already existing transaction",
+		EnvVars: envVars("txpool.pricebump"),
+	}
+	txPoolAccountSlotsFlag = &cli.Uint64Flag{
+		Name:    "txpool.accountslots",
+		Value:   mempool.DefaultTxPoolConfig.AccountSlots,
+		Usage:   "minimum number of executable transaction slots guaranteed per account",
+		EnvVars: envVars("txpool.accountslots"),
+	}
+	txPoolGlobalSlotsFlag = &cli.Uint64Flag{
+		Name:    "txpool.globalslots",
+		Value:   mempool.DefaultTxPoolConfig.GlobalSlots,
+		Usage:   "maximum number of executable transaction slots for all accounts",
+		EnvVars: envVars("txpool.globalslots"),
+	}
+	txPoolAccountQueueFlag = &cli.Uint64Flag{
+		Name:    "txpool.accountqueue",
+		Value:   mempool.DefaultTxPoolConfig.AccountQueue,
+		Usage:   "maximum number of non-executable transaction slots permitted per account",
+		EnvVars: envVars("txpool.accountqueue"),
+	}
+	txPoolGlobalQueueFlag = &cli.Uint64Flag{
+		Name:    "txpool.globalqueue",
+		Value:   mempool.DefaultTxPoolConfig.GlobalQueue,
+		Usage:   "maximum number of non-executable transaction slots for all accounts",
+		EnvVars: envVars("txpool.globalqueue"),
+	}
+	txPoolLifetimeFlag = &cli.DurationFlag{
+		Name:    "txpool.lifetime",
+		Value:   mempool.DefaultTxPoolConfig.Lifetime,
+		Usage:   "maximum amount of time non-executable transaction are queued",
+		EnvVars: envVars("txpool.lifetime"),
+	}
+	txPoolMaxReorgDepthFlag = &cli.Uint64Flag{
+		Name:    "txpool.maxreorgdepth",
+		Value:   mempool.DefaultTxPoolConfig.MaxReorgDepth,
+		Usage:   "maximum reorg depth to reinject transactions for, deeper ones resync the pool",
+		EnvVars: envVars("txpool.maxreorgdepth"),
+	}
+	txPoolHistorySlotsFlag = &cli.Uint64Flag{
+		Name:    "txpool.historyslots",
+		Value:   mempool.DefaultTxPoolConfig.HistorySlots,
+		Usage:   "number of included or dropped transactions remembered for status queries",
+		EnvVars: envVars("txpool.historyslots"),
+	}
+	txPoolHistoryLifetimeFlag = &cli.DurationFlag{
+		Name:    "txpool.historylifetime",
+		Value:   mempool.DefaultTxPoolConfig.HistoryLifetime,
+		Usage:   "maximum amount of time included or dropped transactions are remembered",
+		EnvVars: envVars("txpool.historylifetime"),
+	}
+)
+
+var (
+	nodeFlags = []cli.Flag{
+		configFileFlag,
+		generateFlag,
+		privateFlag,
+		grpcPortFlag,
+		httpPortFlag,
+		rpcPortFlag,
+		rpcOriginsFlag,
+		chainServerFlag,
+		chainIDFlag,
+		chainTLSFlag,
+		chainTLSCAFlag,
+		chainTLSServerCertFlag,
+		chainTLSServerNameFlag,
+		chainTLSCertFlag,
+		chainTLSKeyFlag,
+		networkFlag,
+		genesisFlag,
+		devFlag,
+		devPeriodFlag,
+		devAccountsFlag,
+		shutdownTimeoutFlag,
+		nodeDirFlag,
+		tlsCertFlag,
+		tlsKeyFlag,
+		tlsClientCAFlag,
+		tlsAttestedFlag,
+		authFlag,
+		authAuditFlag,
+	}
+	txPoolFlags = []cli.Flag{
+		txPoolNoLocalsFlag,
+		txPoolJournalFlag,
+		txPoolRejournalFlag,
+		txPoolSnapshotFlag,
+		txPoolPriceLimitFlag,
+		txPoolPriceBumpFlag,
+		txPoolAccountSlotsFlag,
+		txPoolGlobalSlotsFlag,
+		txPoolAccountQueueFlag,
+		txPoolGlobalQueueFlag,
+		txPoolLifetimeFlag,
+		txPoolMaxReorgDepthFlag,
+		txPoolHistorySlotsFlag,
+		txPoolHistoryLifetimeFlag,
+	}
+)
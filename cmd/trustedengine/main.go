@@ -1,14 +1,17 @@
 package main
 
 import (
+	"fmt"
+	"github.com/trusted-defi/trusted-engine/chainserver"
 	"github.com/trusted-defi/trusted-engine/cmd/trustedengine/version"
-	"github.com/trusted-defi/trusted-engine/config"
 	"github.com/trusted-defi/trusted-engine/log"
 	"github.com/trusted-defi/trusted-engine/node"
 	"github.com/trusted-defi/trusted-engine/service"
 	"github.com/urfave/cli/v2"
 	"os"
+	"os/signal"
 	"runtime"
+	"syscall"
 )
 
 func main() {
@@ -17,34 +20,10 @@ func main() {
 	app.Usage = "this is a txpool runing in enclave"
 	app.Action = startNode
 	app.Version = version.Version()
-	app.Commands = []*cli.Command{}
-	app.Flags = []cli.Flag{
-		&cli.BoolFlag{
-			Name:  "generate",
-			Value: false,
-			Usage: "generate test secretdb",
-		},
-		&cli.StringFlag{
-			Name:  "private",
-			Value: "",
-			Usage: "start with given privatekey",
-		},
-		&cli.IntFlag{
-			Name:  "grpc-port",
-			Value: 3802,
-			Usage: "service port",
-		},
-		&cli.StringFlag{
-			Name:  "chain-server",
-			Value: ":3801",
-			Usage: "chain server grpc address",
-		},
-		&cli.StringFlag{
-			Name:  "nodedir",
-			Value: "nodedata",
-			Usage: "node data dir",
-		},
+	app.Commands = []*cli.Command{
+		dumpConfigCommand,
 	}
+	app.Flags = append(append([]cli.Flag{}, nodeFlags...), txPoolFlags...)
 	//app.Flags = appFlags
 
 	app.Before = func(ctx *cli.Context) error {
@@ -69,14 +48,57 @@ func startNode(ctx *cli.Context) error {
 	runtime.GOMAXPROCS(runtime.NumCPU())
 
 	log.Info("start node")
-	nodeconfig := config.NodeConfig{
-		Generate:     ctx.Bool("generate"),
-		GivenPrivate: ctx.String("private"),
-		GrpcPort:     ctx.Int("grpc-port"),
-		NodeDir:      ctx.String("nodedir"),
-		ChainServer:  ctx.String("chain-server"),
+	cfg, err := makeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	nodeconfig := cfg.Node
+	var devchain *chainserver.DevChain
+	if nodeconfig.Dev {
+		if devchain, err = startDevChain(&nodeconfig, nodeconfig.DevAccounts); err != nil {
+			return err
+		}
+	}
+	n, err := node.NewNode(nodeconfig, cfg.TxPool)
+	if err != nil {
+		if devchain != nil {
+			devchain.Stop()
+		}
+		return err
+	}
+	srv, err := service.StartTrustedService(n, nodeconfig)
+	if err != nil {
+		n.Stop()
+		if devchain != nil {
+			devchain.Stop()
+		}
+		return err
+	}
+	if devchain != nil {
+		engineAddr := fmt.Sprintf("127.0.0.1:%d", nodeconfig.GrpcPort)
+		if err := devchain.StartMining(engineAddr, nodeconfig.DevPeriod); err != nil {
+			log.WithField("err", err).Error("dev chain mining not started")
+		}
+	}
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+
+	hupc := make(chan os.Signal, 1)
+	signal.Notify(hupc, syscall.SIGHUP)
+	defer signal.Stop(hupc)
+
+	for {
+		select {
+		case <-hupc:
+			reloadConfig(ctx, n)
+			continue
+		case sig := <-sigc:
+			log.WithField("signal", sig.String()).Info("got interrupt, shutting down")
+		case err := <-srv.Err():
+			log.WithField("err", err).Error("trusted service failed, shutting down")
+		}
+		return shutdown(sigc, nodeconfig.ShutdownTimeout, srv, n, devchain)
 	}
-	n := node.NewNode(nodeconfig)
-	service.StartTrustedService(n, nodeconfig)
-	return nil
 }
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"github.com/trusted-defi/trusted-engine/chainserver"
+	"github.com/trusted-defi/trusted-engine/log"
+	"github.com/trusted-defi/trusted-engine/node"
+	"github.com/trusted-defi/trusted-engine/service"
+	"os"
+	"time"
+)
+
+// shutdown stops the node in order: the service stops taking requests and
+// drains those in flight, the pool writes its journal and snapshot, the chain
+// streams are closed and the dev chain is stopped last. It gives up when the
+// timeout expires or another signal arrives.
+func shutdown(sigc <-chan os.Signal, timeout time.Duration, srv *service.TrustedServer, n *node.Node, devchain *chainserver.DevChain) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		srv.Stop(ctx)
+		n.Stop()
+		if devchain != nil {
+			devchain.Stop()
+		}
+	}()
+
+	// Requests in flight are cancelled at the deadline, give the remaining
+	// steps a moment before giving up.
+	select {
+	case <-done:
+		log.Info("node stopped")
+		return nil
+	case <-sigc:
+		return errors.New("interrupted again, shutdown aborted")
+	case <-time.After(timeout + time.Second):
+		return errors.New("shutdown timed out")
+	}
+}
@@ -0,0 +1,28 @@
+package config
+
+// AuthConfig configures which callers may use which service methods. Callers
+// are identified by an API token in the authorization metadata or by a client
+// certificate, and get the roles configured for them.
+type AuthConfig struct {
+	Enabled   bool        // without it every caller has every role
+	Audit     bool        // log every allowed call at info, not only the denied ones
+	Anonymous []string    // roles of callers without credentials
+	Tokens    []AuthToken `toml:",omitempty"`
+	Certs     []AuthCert  `toml:",omitempty"`
+}
+
+// AuthToken grants roles to the callers presenting the token.
+type AuthToken struct {
+	Name  string // identifies the caller in the logs
+	Token string
+	Roles []string
+}
+
+// AuthCert grants roles to the callers presenting a verified client
+// certificate with the given subject common name or SHA-256 fingerprint.
+type AuthCert struct {
+	Name        string // identifies the caller in the logs, default the subject
+	Subject     string `toml:",omitempty"`
+	Fingerprint string `toml:",omitempty"` // hex of the SHA-256 of the DER certificate
+	Roles       []string
+}
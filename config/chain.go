@@ -0,0 +1,163 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/params"
+	"math/big"
+	"os"
+	"sort"
+)
+
+// PrivateChainConfig is the chain config of the private trusted chain, all the
+// forks up to london are active from genesis.
+var PrivateChainConfig = &params.ChainConfig{
+	ChainID:             big.NewInt(1024),
+	HomesteadBlock:      big.NewInt(0),
+	EIP150Block:         big.NewInt(0),
+	EIP155Block:         big.NewInt(0),
+	EIP158Block:         big.NewInt(0),
+	ByzantiumBlock:      big.NewInt(0),
+	ConstantinopleBlock: big.NewInt(0),
+	PetersburgBlock:     big.NewInt(0),
+	IstanbulBlock:       big.NewInt(0),
+	MuirGlacierBlock:    big.NewInt(0),
+	BerlinBlock:         big.NewInt(0),
+	LondonBlock:         big.NewInt(0),
+	Ethash:              new(params.EthashConfig),
+}
+
+// networkPresets are the chain configs selectable by network name.
+var networkPresets = map[string]*params.ChainConfig{
+	"mainnet": params.MainnetChainConfig,
+	"goerli":  params.GoerliChainConfig,
+	"sepolia": params.SepoliaChainConfig,
+	"private": PrivateChainConfig,
+}
+
+// networkGenesis are the genesis hashes of the network presets. The private
+// chain has none, each deployment has its own genesis.
+var networkGenesis = map[string]common.Hash{
+	"mainnet": params.MainnetGenesisHash,
+	"goerli":  params.GoerliGenesisHash,
+	"sepolia": params.SepoliaGenesisHash,
+}
+
+// Networks returns the names of the network presets.
+func Networks() []string {
+	names := make([]string, 0, len(networkPresets))
+	for name := range networkPresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NetworkChainConfig returns a copy of the chain config of the named network.
+func NetworkChainConfig(name string) (*params.ChainConfig, error) {
+	preset, ok := networkPresets[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown network %q, available %v", name, Networks())
+	}
+	return copyChainConfig(preset)
+}
+
+// LoadChainConfig reads the chain config from a genesis file, a file holding
+// only the chain config is accepted as well.
+func LoadChainConfig(path string) (*params.ChainConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var genesis struct {
+		Config *params.ChainConfig `json:"config"`
+	}
+	if err := json.Unmarshal(data, &genesis); err != nil {
+		return nil, fmt.Errorf("invalid genesis file %s: %v", path, err)
+	}
+	chainconfig := genesis.Config
+	if chainconfig == nil {
+		chainconfig = new(params.ChainConfig)
+		if err := json.Unmarshal(data, chainconfig); err != nil {
+			return nil, fmt.Errorf("invalid chain config %s: %v", path, err)
+		}
+	}
+	if chainconfig.ChainID == nil {
+		return nil, fmt.Errorf("chain config %s has no chain id", path)
+	}
+	return chainconfig, nil
+}
+
+// LoadGenesisHash returns the hash of the genesis block of a genesis file, zero
+// if the file holds only the chain config or no allocation.
+func LoadGenesisHash(path string) (common.Hash, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return common.Hash{}, fmt.Errorf("invalid genesis file %s: %v", path, err)
+	}
+	if fields["config"] == nil || fields["alloc"] == nil {
+		return common.Hash{}, nil
+	}
+	genesis := new(core.Genesis)
+	if err := json.Unmarshal(data, genesis); err != nil {
+		return common.Hash{}, fmt.Errorf("invalid genesis file %s: %v", path, err)
+	}
+	return genesis.ToBlock().Hash(), nil
+}
+
+// ChainConfig resolves the chain config given by the node config, preferring a
+// genesis file over a network preset. It returns nil if neither is set, then the
+// config announced by chain server is used. A configured chain id must agree
+// with the one of the chain config.
+func (c NodeConfig) ChainConfig() (*params.ChainConfig, error) {
+	var (
+		chainconfig *params.ChainConfig
+		err         error
+	)
+	switch {
+	case c.GenesisFile != "":
+		chainconfig, err = LoadChainConfig(c.GenesisFile)
+	case c.Network != "":
+		chainconfig, err = NetworkChainConfig(c.Network)
+	}
+	if err != nil || chainconfig == nil {
+		return nil, err
+	}
+	if c.ChainID != 0 && chainconfig.ChainID.Cmp(new(big.Int).SetUint64(c.ChainID)) != 0 {
+		return nil, fmt.Errorf("chain id %d doesn't match chain id %v of the chain config", c.ChainID, chainconfig.ChainID)
+	}
+	return chainconfig, nil
+}
+
+// GenesisHash resolves the genesis hash of the chain given by the node config
+// like ChainConfig. It returns zero if it is unknown, then chain servers of any
+// genesis are accepted.
+func (c NodeConfig) GenesisHash() (common.Hash, error) {
+	switch {
+	case c.GenesisFile != "":
+		return LoadGenesisHash(c.GenesisFile)
+	case c.Network != "":
+		return networkGenesis[c.Network], nil
+	}
+	return common.Hash{}, nil
+}
+
+// copyChainConfig deep copies the chain config, so that the shared presets of
+// go-ethereum are never modified.
+func copyChainConfig(chainconfig *params.ChainConfig) (*params.ChainConfig, error) {
+	data, err := json.Marshal(chainconfig)
+	if err != nil {
+		return nil, err
+	}
+	cpy := new(params.ChainConfig)
+	if err := json.Unmarshal(data, cpy); err != nil {
+		return nil, err
+	}
+	return cpy, nil
+}
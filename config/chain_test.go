@@ -0,0 +1,161 @@
+package config
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func writeFile(t *testing.T, data string) string {
+	path := filepath.Join(t.TempDir(), "genesis.json")
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestNetworkChainConfig(t *testing.T) {
+	presets := map[string]*params.ChainConfig{
+		"mainnet": params.MainnetChainConfig,
+		"goerli":  params.GoerliChainConfig,
+		"sepolia": params.SepoliaChainConfig,
+		"private": PrivateChainConfig,
+	}
+	for name, preset := range presets {
+		chainconfig, err := NetworkChainConfig(name)
+		if err != nil {
+			t.Fatalf("%s: failed to load preset: %v", name, err)
+		}
+		if !reflect.DeepEqual(chainconfig, preset) {
+			t.Errorf("%s: chain config mismatch: have %v, want %v", name, chainconfig, preset)
+		}
+		// The copy must not alias the shared presets
+		if chainconfig == preset || chainconfig.ChainID == preset.ChainID || chainconfig.LondonBlock == preset.LondonBlock {
+			t.Fatalf("%s: chain config aliases the preset", name)
+		}
+		want := new(big.Int).Set(preset.ChainID)
+		chainconfig.ChainID.SetInt64(999)
+		chainconfig.LondonBlock = nil
+		if preset.ChainID.Cmp(want) != 0 || preset.LondonBlock == nil {
+			t.Fatalf("%s: modifying the copy changed the preset", name)
+		}
+	}
+	if _, err := NetworkChainConfig("unknown"); err == nil {
+		t.Error("unknown network accepted")
+	}
+	if _, err := (NodeConfig{Network: "unknown"}).ChainConfig(); err == nil {
+		t.Error("node config with unknown network accepted")
+	}
+}
+
+func TestLoadChainConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		chainID int64
+		london  *big.Int
+	}{
+		{"genesis file", `{"config":{"chainId":1024,"londonBlock":5},"alloc":{}}`, 1024, big.NewInt(5)},
+		{"chain config file", `{"chainId":7,"londonBlock":0}`, 7, big.NewInt(0)},
+		{"no chain id", `{"config":{"londonBlock":5}}`, 0, nil},
+		{"invalid", `{"config":`, 0, nil},
+	}
+	for _, tt := range tests {
+		chainconfig, err := LoadChainConfig(writeFile(t, tt.data))
+		if tt.chainID == 0 {
+			if err == nil {
+				t.Errorf("%s: chain config accepted: %v", tt.name, chainconfig)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: failed to load: %v", tt.name, err)
+			continue
+		}
+		if chainconfig.ChainID.Int64() != tt.chainID || chainconfig.LondonBlock.Cmp(tt.london) != 0 {
+			t.Errorf("%s: chain config mismatch: have %v", tt.name, chainconfig)
+		}
+	}
+	if _, err := LoadChainConfig(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("missing file accepted")
+	}
+}
+
+// Tests that the chain config of the node config prefers the genesis file and
+// must agree with a configured chain id.
+func TestNodeChainConfig(t *testing.T) {
+	genesis := writeFile(t, `{"config":{"chainId":7}}`)
+	tests := []struct {
+		name    string
+		conf    NodeConfig
+		chainID int64 // 0 if none, -1 if refused
+	}{
+		{"none", NodeConfig{}, 0},
+		{"network", NodeConfig{Network: "goerli"}, 5},
+		{"genesis over network", NodeConfig{Network: "goerli", GenesisFile: genesis}, 7},
+		{"matching chain id", NodeConfig{Network: "goerli", ChainID: 5}, 5},
+		{"mismatching chain id", NodeConfig{Network: "goerli", ChainID: 1}, -1},
+		{"mismatching genesis chain id", NodeConfig{GenesisFile: genesis, ChainID: 5}, -1},
+		{"chain id only", NodeConfig{ChainID: 5}, 0},
+	}
+	for _, tt := range tests {
+		chainconfig, err := tt.conf.ChainConfig()
+		switch {
+		case tt.chainID < 0:
+			if err == nil {
+				t.Errorf("%s: chain id mismatch accepted", tt.name)
+			}
+		case err != nil:
+			t.Errorf("%s: failed to resolve: %v", tt.name, err)
+		case tt.chainID == 0:
+			if chainconfig != nil {
+				t.Errorf("%s: unexpected chain config %v", tt.name, chainconfig)
+			}
+		case chainconfig == nil || chainconfig.ChainID.Int64() != tt.chainID:
+			t.Errorf("%s: chain config mismatch: have %v, want chain id %d", tt.name, chainconfig, tt.chainID)
+		}
+	}
+}
+
+func TestGenesisHash(t *testing.T) {
+	genesis := `{"config":{"chainId":1024},"difficulty":"0x1","gasLimit":"0x1000000","alloc":{"0x0000000000000000000000000000000000000001":{"balance":"0x10"}}}`
+	want := (&core.Genesis{
+		Config:     &params.ChainConfig{ChainID: PrivateChainConfig.ChainID},
+		Difficulty: common.Big1,
+		GasLimit:   0x1000000,
+		Alloc:      core.GenesisAlloc{common.HexToAddress("0x01"): {Balance: big.NewInt(16)}},
+	}).ToBlock().Hash()
+
+	tests := []struct {
+		name string
+		conf NodeConfig
+		want common.Hash
+	}{
+		{"none", NodeConfig{}, common.Hash{}},
+		{"mainnet", NodeConfig{Network: "mainnet"}, params.MainnetGenesisHash},
+		{"private", NodeConfig{Network: "private"}, common.Hash{}},
+		{"genesis file", NodeConfig{GenesisFile: writeFile(t, genesis)}, want},
+		{"genesis over network", NodeConfig{GenesisFile: writeFile(t, genesis), Network: "mainnet"}, want},
+		{"chain config file", NodeConfig{GenesisFile: writeFile(t, `{"chainId":1024}`)}, common.Hash{}},
+		{"no alloc", NodeConfig{GenesisFile: writeFile(t, `{"config":{"chainId":1024}}`)}, common.Hash{}},
+	}
+	for _, tt := range tests {
+		hash, err := tt.conf.GenesisHash()
+		if err != nil {
+			t.Errorf("%s: failed to resolve genesis: %v", tt.name, err)
+			continue
+		}
+		if hash != tt.want {
+			t.Errorf("%s: genesis mismatch: have %x, want %x", tt.name, hash, tt.want)
+		}
+	}
+	if _, err := (NodeConfig{GenesisFile: writeFile(t, `{"config":`)}).GenesisHash(); err == nil {
+		t.Error("invalid genesis file accepted")
+	}
+}
@@ -1,27 +1,66 @@
 package config
 
-var gconfig = &defaultConfig
+import "time"
 
-type Config struct {
-	// chain server
-	ChainServerAddr string
-	//
-	GrpcAddr string
-}
-
-var defaultConfig = Config{
-	ChainServerAddr: ":3801",
-	GrpcAddr:        "0.0.0.0:3802",
-}
-
-func GetConfig() *Config {
-	return gconfig
+// DefaultNodeConfig contains the default node settings, the config file, the
+// environment and the flags are layered on top of it.
+var DefaultNodeConfig = NodeConfig{
+	GrpcPort:        3802,
+	NodeDir:         "nodedata",
+	ChainServer:     ":3801",
+	DevPeriod:       5 * time.Second,
+	DevAccounts:     4,
+	ShutdownTimeout: 30 * time.Second,
+	Auth: AuthConfig{
+		Anonymous: []string{"public"},
+	},
 }
 
 type NodeConfig struct {
-	Generate     bool
-	GivenPrivate string
+	Generate     bool   `toml:"-"`
+	GivenPrivate string `toml:"-"`
 	GrpcPort     int
+	HTTPPort     int      // port of the REST/JSON gateway, 0 to disable
+	RPCPort      int      // port of the eth JSON-RPC server over http and websocket, 0 to disable
+	RPCOrigins   []string `toml:",omitempty"` // origins allowed to open websockets, localhost if empty
 	NodeDir      string
 	ChainServer  string
+	ChainID      uint64
+	ChainTLS     ChainTLSConfig // transport security of the chain server connection
+	Network      string         `toml:",omitempty"`
+	GenesisFile  string         `toml:",omitempty"`
+
+	Dev         bool          // simulated chain, the key is kept in memory only
+	DevPeriod   time.Duration // block period of the simulated chain
+	DevAccounts int           // number of pre-funded accounts of the simulated chain
+
+	ShutdownTimeout time.Duration // limit for draining requests and stopping the node
+
+	TLS  TLSConfig  // transport security of the service
+	Auth AuthConfig // callers allowed to use the service methods
+}
+
+// TLSConfig configures the transport security of a grpc server.
+type TLSConfig struct {
+	Cert     string `toml:",omitempty"` // PEM certificate file, plaintext if empty
+	Key      string `toml:",omitempty"` // PEM key file
+	ClientCA string `toml:",omitempty"` // PEM file of the CAs verifying client certificates
+	Attested bool   `toml:",omitempty"` // enclave generated certificate embedding an attestation report
+}
+
+// ChainTLSConfig configures the transport security of the chain server
+// connection. The server is verified against the pinned certificate if given,
+// else against the CA file or the system roots.
+type ChainTLSConfig struct {
+	Enabled    bool   // implied by the other settings
+	CA         string `toml:",omitempty"` // PEM file of the CAs verifying the chain server
+	ServerCert string `toml:",omitempty"` // PEM file of the pinned chain server certificates
+	ServerName string `toml:",omitempty"` // expected name in the certificate, default the address host
+	Cert       string `toml:",omitempty"` // PEM client certificate file for mutual authentication
+	Key        string `toml:",omitempty"` // PEM key file of the client certificate
+}
+
+// On reports whether the chain server connection uses TLS.
+func (c ChainTLSConfig) On() bool {
+	return c.Enabled || c.CA != "" || c.ServerCert != "" || c.Cert != ""
 }
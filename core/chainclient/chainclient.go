@@ -3,59 +3,196 @@ package chainclient
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/trusted-defi/trusted-engine/config"
 	corecmn "github.com/trusted-defi/trusted-engine/core/common"
 	"github.com/trusted-defi/trusted-engine/log"
 	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"math/big"
+	"reflect"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
+const (
+	blockCacheLimit  = 256
+	headerCacheLimit = 1024
+)
+
+// ChainHeaderEvent is posted when a new head header is received from chain server.
+type ChainHeaderEvent struct {
+	Header *types.Header
+}
+
 type ChainClient struct {
 	cclient trusted.ChainServiceClient
 
-	chainHeadFeed event.Feed
-	scope         event.SubscriptionScope
-	quit          chan struct{}
-	ctx           context.Context
+	blockCache  *lru.Cache // Cache for the most recent blocks, used by reorg walks
+	headerCache *lru.Cache // Cache for the most recent block headers
+
+	chainHeadFeed   event.Feed
+	chainHeaderFeed event.Feed
+	headScope       event.SubscriptionScope
+	headerScope     event.SubscriptionScope
+	quit            chan struct{}
+	closeOnce       sync.Once
+	wg              sync.WaitGroup
+	ctx             context.Context
+	cancel          context.CancelFunc // Aborts the calls and streams in flight
+	closeConn       func()             // Closes the connection to chain server
+
+	noBlockRange  int32        // Set if chain server doesn't support GetBlocks (atomic)
+	noHeaderFetch int32        // Set if chain server doesn't support GetHeader (atomic)
+	connected     int32        // Set while the head stream of chain server is up (atomic)
+	tls           atomic.Value // *tlsState of the latest chain server handshake
+
+	chainID *big.Int     // Expected chain id, nil to accept any
+	genesis common.Hash  // Expected genesis hash, zero to accept any
+	info    *ChainInfo   // Negotiated chain info, nil until chain server was reached
+	refused error        // Why chain server was refused after startup, nil if it wasn't
+	infoMu  sync.RWMutex // Protects info and refused
 }
 
 func NewChainClient(nodeconfig config.NodeConfig) (*ChainClient, error) {
-	c, err := grpc.Dial(nodeconfig.ChainServer, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	client := new(ChainClient)
+	client.ctx, client.cancel = context.WithCancel(context.Background())
+	closeConn := func() {}
+	tlsConfig, err := clientTLSConfig(nodeconfig.ChainTLS, nodeconfig.ChainServer, func(err error) {
+		client.tls.Store(&tlsState{err: err})
+	})
 	if err != nil {
-		return nil, errors.New("dial server failed")
+		client.cancel()
+		return nil, fmt.Errorf("chain server tls: %w", err)
 	}
-
-	log.Info("grpc connected")
-	client := new(ChainClient)
-	client.ctx = context.Background()
-	client.cclient = trusted.NewChainServiceClient(c)
+	if isRPCURL(nodeconfig.ChainServer) {
+		backend, err := dialRPC(client.ctx, nodeconfig.ChainServer, tlsConfig)
+		if err != nil {
+			client.cancel()
+			return nil, fmt.Errorf("dial json-rpc server failed: %v", err)
+		}
+		log.Info("json-rpc connected")
+		client.cclient = backend
+		closeConn = backend.client.Close
+	} else {
+		creds := insecure.NewCredentials()
+		if tlsConfig != nil {
+			creds = credentials.NewTLS(tlsConfig)
+		}
+		c, err := grpc.Dial(nodeconfig.ChainServer, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			client.cancel()
+			return nil, errors.New("dial server failed")
+		}
+		log.Info("grpc connected")
+		client.cclient = trusted.NewChainServiceClient(c)
+		closeConn = func() { c.Close() }
+	}
+	client.blockCache, _ = lru.New(blockCacheLimit)
+	client.headerCache, _ = lru.New(headerCacheLimit)
 	client.quit = make(chan struct{})
+	client.closeConn = closeConn
+	if nodeconfig.ChainID != 0 {
+		client.chainID = new(big.Int).SetUint64(nodeconfig.ChainID)
+	}
+	// The dev chain has its own genesis, whatever network it simulates
+	if !nodeconfig.Dev {
+		if client.genesis, err = nodeconfig.GenesisHash(); err != nil {
+			client.cancel()
+			closeConn()
+			return nil, err
+		}
+	}
+	if _, err := client.negotiate(); err != nil {
+		if !isTransient(err) {
+			client.cancel()
+			closeConn()
+			return nil, err
+		}
+		log.WithField("err", err).Warn("chain server not reachable, negotiate later")
+	}
 	client.Start()
 
 	return client, nil
 }
 
+// isTransient reports whether the error is a connectivity problem rather than
+// an incompatible chain server.
+func isTransient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+		return true
+	}
+	return false
+}
+
 func (client *ChainClient) Start() {
+	client.wg.Add(1)
 	go client.loop()
 }
 
+// Close stops following the chain, aborts the streams and calls in flight,
+// ends the head subscriptions and closes the connection to chain server.
+func (client *ChainClient) Close() {
+	client.closeOnce.Do(func() {
+		close(client.quit)
+		client.cancel()
+		client.wg.Wait()
+
+		client.headScope.Close()
+		client.headerScope.Close()
+		client.closeConn()
+		log.Info("chain client closed")
+	})
+}
+
 func (client *ChainClient) CurrentBlock() (*types.Block, error) {
 	latest, err := client.cclient.CurrentBlock(client.ctx, new(trusted.CurrentBlockRequest), grpc.EmptyCallOption{})
 	if err != nil {
 		log.Error("get current block failed", "err", err)
 		return nil, err
 	}
-	return corecmn.ParseBlockData(latest.BlockData), nil
+	if len(latest.BlockData) == 0 {
+		return nil, errors.New("empty current block")
+	}
+	block := corecmn.ParseBlockData(latest.BlockData)
+	if block == nil {
+		return nil, errors.New("invalid current block")
+	}
+	client.cacheBlock(block)
+	return block, nil
+}
+
+// CurrentHeader returns the header of the current head block, without fetching
+// the block body.
+func (client *ChainClient) CurrentHeader() (*types.Header, error) {
+	latest, err := client.cclient.LatestHeader(client.ctx, new(trusted.LatestHeaderRequest), grpc.EmptyCallOption{})
+	if err != nil {
+		log.Error("get latest header failed", "err", err)
+		return nil, err
+	}
+	header := corecmn.ParseBlockHeader(latest)
+	if header == nil {
+		return nil, errors.New("invalid latest header")
+	}
+	client.cacheHeader(header)
+	return header, nil
 }
 
 func (client *ChainClient) GetBlock(hash common.Hash, number uint64) *types.Block {
+	if cached, ok := client.blockCache.Get(hash); ok {
+		return cached.(*types.Block)
+	}
 	req := new(trusted.BlockRequest)
 	req.BlockHash = hash.Bytes()
 	req.BlockNum = number
@@ -64,7 +201,132 @@ func (client *ChainClient) GetBlock(hash common.Hash, number uint64) *types.Bloc
 		log.Error("get current block failed", "err", err)
 		return nil
 	}
-	return corecmn.ParseBlockData(block.BlockData)
+	if len(block.BlockData) == 0 {
+		return nil
+	}
+	b := corecmn.ParseBlockData(block.BlockData)
+	client.cacheBlock(b)
+	return b
+}
+
+// GetHeader returns the header of the block with the given hash and number. The
+// header is served from cache when possible and otherwise fetched from chain
+// server, falling back to the header of the block if chain server doesn't
+// support header requests. Only the header is cached.
+func (client *ChainClient) GetHeader(hash common.Hash, number uint64) *types.Header {
+	if cached, ok := client.headerCache.Get(hash); ok {
+		return cached.(*types.Header)
+	}
+	header := client.fetchHeader(hash, number)
+	if header == nil || header.Hash() != hash {
+		return nil
+	}
+	client.cacheHeader(header)
+	return header
+}
+
+// fetchHeader requests a single header from chain server.
+func (client *ChainClient) fetchHeader(hash common.Hash, number uint64) *types.Header {
+	if client.ChainInfo().mayUse(FeatureHeaderFetch) && atomic.LoadInt32(&client.noHeaderFetch) == 0 {
+		req := new(trusted.HeaderRequest)
+		req.BlockHash = hash.Bytes()
+		req.BlockNum = number
+		res, err := client.cclient.GetHeader(client.ctx, req, grpc.EmptyCallOption{})
+		if err == nil {
+			if len(res.HeaderData) == 0 {
+				return nil
+			}
+			return corecmn.ParseHeaderData(res.HeaderData)
+		}
+		if status.Code(err) != codes.Unimplemented {
+			log.Error("get header failed", "err", err)
+			return nil
+		}
+		log.Warn("chain server has no header api, fallback to block fetch")
+		atomic.StoreInt32(&client.noHeaderFetch, 1)
+	}
+	req := new(trusted.BlockRequest)
+	req.BlockHash = hash.Bytes()
+	req.BlockNum = number
+	res, err := client.cclient.GetBlock(client.ctx, req, grpc.EmptyCallOption{})
+	if err != nil {
+		log.Error("get block failed", "err", err)
+		return nil
+	}
+	if len(res.BlockData) == 0 {
+		return nil
+	}
+	if block := corecmn.ParseBlockData(res.BlockData); block != nil {
+		return block.Header()
+	}
+	return nil
+}
+
+// GetBlocks returns the block with the given hash and number together with up to
+// count-1 of its ancestors, ordered from newest to oldest. Cached blocks are served
+// locally and the rest is fetched with a single range request.
+func (client *ChainClient) GetBlocks(hash common.Hash, number uint64, count uint64) []*types.Block {
+	blocks := make([]*types.Block, 0, count)
+	for uint64(len(blocks)) < count {
+		cached, ok := client.blockCache.Get(hash)
+		if !ok {
+			break
+		}
+		block := cached.(*types.Block)
+		blocks = append(blocks, block)
+		if block.NumberU64() == 0 {
+			return blocks
+		}
+		hash, number = block.ParentHash(), block.NumberU64()-1
+	}
+	if uint64(len(blocks)) == count {
+		return blocks
+	}
+	return append(blocks, client.fetchBlocks(hash, number, count-uint64(len(blocks)))...)
+}
+
+// fetchBlocks requests a range of blocks from chain server, falling back to one
+// request per block if chain server doesn't support range requests.
+func (client *ChainClient) fetchBlocks(hash common.Hash, number uint64, count uint64) []*types.Block {
+	blocks := make([]*types.Block, 0, count)
+	if client.ChainInfo().mayUse(FeatureBlockRange) && atomic.LoadInt32(&client.noBlockRange) == 0 {
+		req := new(trusted.BlockRangeRequest)
+		req.BlockHash = hash.Bytes()
+		req.BlockNum = number
+		req.Count = count
+		res, err := client.cclient.GetBlocks(client.ctx, req, grpc.EmptyCallOption{})
+		if err == nil {
+			for _, data := range res.BlockData {
+				block := corecmn.ParseBlockData(data)
+				// Drop anything which doesn't link to the requested chain.
+				if block == nil || len(data) == 0 || block.Hash() != hash {
+					break
+				}
+				client.cacheBlock(block)
+				blocks = append(blocks, block)
+				hash = block.ParentHash()
+			}
+			return blocks
+		}
+		if status.Code(err) != codes.Unimplemented {
+			log.Error("get blocks failed", "err", err)
+			return blocks
+		}
+		log.Warn("chain server has no block range api, fallback to single block fetch")
+		atomic.StoreInt32(&client.noBlockRange, 1)
+	}
+	for uint64(len(blocks)) < count {
+		block := client.GetBlock(hash, number)
+		if block == nil {
+			break
+		}
+		blocks = append(blocks, block)
+		if number == 0 {
+			break
+		}
+		hash, number = block.ParentHash(), number-1
+	}
+	return blocks
 }
 
 func (client *ChainClient) GetBalance(addr common.Address) *big.Int {
@@ -101,37 +363,184 @@ func (client *ChainClient) NonceAt(addr common.Address) uint64 {
 	return corecmn.ParseNonce(nonce)
 }
 
+// SubscribeChainHeadEvent registers a subscription of full head blocks. Bodies
+// are only fetched from chain server while there is at least one subscriber.
 func (client *ChainClient) SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription {
-	return client.scope.Track(client.chainHeadFeed.Subscribe(ch))
+	return client.headScope.Track(client.chainHeadFeed.Subscribe(ch))
+}
+
+// SubscribeChainHeaderEvent registers a subscription of head headers.
+func (client *ChainClient) SubscribeChainHeaderEvent(ch chan<- ChainHeaderEvent) event.Subscription {
+	return client.headerScope.Track(client.chainHeaderFeed.Subscribe(ch))
+}
+
+// hasHeader reports whether the block has a header. A zero Block has none and
+// all its accessors panic, so the field is checked directly.
+func hasHeader(block *types.Block) bool {
+	return block != nil && !reflect.ValueOf(block).Elem().FieldByName("header").IsNil()
+}
+
+// cacheBlock adds the block and its header to the caches, blocks without a
+// header are skipped.
+func (client *ChainClient) cacheBlock(block *types.Block) {
+	if !hasHeader(block) {
+		return
+	}
+	client.blockCache.Add(block.Hash(), block)
+	client.headerCache.Add(block.Hash(), block.Header())
+}
+
+func (client *ChainClient) cacheHeader(header *types.Header) {
+	if header == nil {
+		return
+	}
+	client.headerCache.Add(header.Hash(), header)
+}
+
+// postHeader announces a new head header, and the full block to the block
+// subscribers if there are any.
+func (client *ChainClient) postHeader(header *types.Header) {
+	client.cacheHeader(header)
+	client.chainHeaderFeed.Send(ChainHeaderEvent{Header: header})
+	if client.headScope.Count() > 0 {
+		if block := client.GetBlock(header.Hash(), header.Number.Uint64()); block != nil {
+			client.chainHeadFeed.Send(core.ChainHeadEvent{Block: block})
+		}
+	}
+}
+
+// postBlock announces a new head block received from the legacy block stream.
+func (client *ChainClient) postBlock(block *types.Block) {
+	client.cacheBlock(block)
+	client.chainHeaderFeed.Send(ChainHeaderEvent{Header: block.Header()})
+	client.chainHeadFeed.Send(core.ChainHeadEvent{Block: block})
 }
 
 func (client *ChainClient) loop() {
+	defer client.wg.Done()
+
+	headerOnly := client.ChainInfo().mayUse(FeatureHeaderStream)
 	for {
-		subsucceed := false
-		sub, err := client.cclient.ChainHeadEvent(client.ctx, new(trusted.ChainHeadEventRequest))
-		if err != nil {
-			log.Error("chain head event subscribe failed", "err", err)
-			time.Sleep(time.Second)
-			continue
+		select {
+		case <-client.quit:
+			log.Info("chain client quit")
+			return
+		default:
+		}
+		if client.ChainInfo() == nil {
+			info, err := client.negotiate()
+			if err != nil {
+				if !isTransient(err) {
+					log.WithField("err", err).Error("chain server incompatible, stop following chain")
+					client.infoMu.Lock()
+					client.refused = err
+					client.infoMu.Unlock()
+					return
+				}
+				client.retryWait()
+				continue
+			}
+			headerOnly = info.mayUse(FeatureHeaderStream)
+		}
+		var err error
+		if headerOnly {
+			err = client.headerEventLoop()
+			if status.Code(err) == codes.Unimplemented {
+				log.Warn("chain server has no header stream, fallback to block stream")
+				headerOnly = false
+				continue
+			}
+		} else {
+			err = client.blockEventLoop()
 		}
-		subsucceed = true
-		for subsucceed {
+		if err != nil {
 			select {
 			case <-client.quit:
-				log.Info("chain client quit")
-				return
 			default:
-
-			}
-
-			if res, err := sub.Recv(); err != nil {
 				log.Info("chain head event receive failed", "err", err)
-				subsucceed = false
-			} else {
-				client.chainHeadFeed.Send(core.ChainHeadEvent{
-					Block: corecmn.ParseBlockData(res.BlockData),
-				})
+				client.retryWait()
 			}
 		}
 	}
 }
+
+// retryWait waits a second before reconnecting, or until the client is closed.
+func (client *ChainClient) retryWait() {
+	select {
+	case <-time.After(time.Second):
+	case <-client.quit:
+	}
+}
+
+// Connected reports whether the head stream of chain server is up.
+func (client *ChainClient) Connected() bool {
+	return atomic.LoadInt32(&client.connected) == 1
+}
+
+// Refused returns why chain server was refused when it was first reached after
+// startup, nil if it wasn't. A chain server refused at startup fails
+// NewChainClient instead.
+func (client *ChainClient) Refused() error {
+	client.infoMu.RLock()
+	defer client.infoMu.RUnlock()
+
+	return client.refused
+}
+
+// TLSError returns why the latest chain server handshake failed verification,
+// nil if it passed or the connection is plaintext.
+func (client *ChainClient) TLSError() error {
+	if state, ok := client.tls.Load().(*tlsState); ok {
+		return state.err
+	}
+	return nil
+}
+
+// headerEventLoop receives head headers until the stream fails.
+func (client *ChainClient) headerEventLoop() error {
+	sub, err := client.cclient.ChainHeaderEvent(client.ctx, new(trusted.ChainHeaderEventRequest))
+	if err != nil {
+		return err
+	}
+	atomic.StoreInt32(&client.connected, 1)
+	defer atomic.StoreInt32(&client.connected, 0)
+	for {
+		select {
+		case <-client.quit:
+			return nil
+		default:
+		}
+		res, err := sub.Recv()
+		if err != nil {
+			return err
+		}
+		if header := corecmn.ParseHeaderData(res.HeaderData); header != nil {
+			client.postHeader(header)
+		}
+	}
+}
+
+// blockEventLoop receives full head blocks until the stream fails, it is used
+// for chain servers without header stream.
+func (client *ChainClient) blockEventLoop() error {
+	sub, err := client.cclient.ChainHeadEvent(client.ctx, new(trusted.ChainHeadEventRequest))
+	if err != nil {
+		return err
+	}
+	atomic.StoreInt32(&client.connected, 1)
+	defer atomic.StoreInt32(&client.connected, 0)
+	for {
+		select {
+		case <-client.quit:
+			return nil
+		default:
+		}
+		res, err := sub.Recv()
+		if err != nil {
+			return err
+		}
+		if block := corecmn.ParseBlockData(res.BlockData); block != nil {
+			client.postBlock(block)
+		}
+	}
+}
@@ -6,11 +6,25 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/trusted-defi/trusted-engine/config"
 	"math/big"
+	"net"
 	"testing"
+	"time"
 )
 
+// testNodeConfig returns the node config pointing to the local chain server, the
+// test is skipped if no chain server is listening.
+func testNodeConfig(t *testing.T) config.NodeConfig {
+	addr := config.DefaultNodeConfig.ChainServer
+	conn, err := net.DialTimeout("tcp", addr, time.Second)
+	if err != nil {
+		t.Skipf("chain server %s not reachable: %v", addr, err)
+	}
+	conn.Close()
+	return config.NodeConfig{ChainServer: addr}
+}
+
 func TestChainClient_CurrentBlock(t *testing.T) {
-	client, err := NewChainClient(config.GetConfig())
+	client, err := NewChainClient(testNodeConfig(t))
 	if err != nil {
 		t.Error("new chain client failed", err)
 	}
@@ -22,7 +36,7 @@ func TestChainClient_CurrentBlock(t *testing.T) {
 }
 
 func TestChainClient_GetBalance(t *testing.T) {
-	client, err := NewChainClient(config.GetConfig())
+	client, err := NewChainClient(testNodeConfig(t))
 	if err != nil {
 		t.Error("new chain client failed", err)
 	}
@@ -36,7 +50,7 @@ func TestChainClient_GetBalance(t *testing.T) {
 }
 
 func TestChainClient_NonceAt(t *testing.T) {
-	client, err := NewChainClient(config.GetConfig())
+	client, err := NewChainClient(testNodeConfig(t))
 	if err != nil {
 		t.Error("new chain client failed", err)
 	}
@@ -46,7 +60,7 @@ func TestChainClient_NonceAt(t *testing.T) {
 }
 
 func TestChainClient_NonceAtHeight(t *testing.T) {
-	client, err := NewChainClient(config.GetConfig())
+	client, err := NewChainClient(testNodeConfig(t))
 	if err != nil {
 		t.Error("new chain client failed", err)
 	}
@@ -56,7 +70,7 @@ func TestChainClient_NonceAtHeight(t *testing.T) {
 }
 
 func TestChainClient_SubscribeChainHeadEvent(t *testing.T) {
-	client, err := NewChainClient(config.GetConfig())
+	client, err := NewChainClient(testNodeConfig(t))
 	if err != nil {
 		t.Error("new chain client failed", err)
 	}
@@ -77,3 +91,23 @@ func TestChainClient_SubscribeChainHeadEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestChainClient_SubscribeChainHeaderEvent(t *testing.T) {
+	client, err := NewChainClient(testNodeConfig(t))
+	if err != nil {
+		t.Error("new chain client failed", err)
+	}
+	ch := make(chan ChainHeaderEvent, 10)
+	sub := client.SubscribeChainHeaderEvent(ch)
+	for i := 0; i < 5; i++ {
+		select {
+		case err := <-sub.Err():
+			fmt.Printf("subscribe error %v\n", err)
+		case event := <-ch:
+			fmt.Printf("got new header.number = %d\n", event.Header.Number.Uint64())
+			if header := client.GetHeader(event.Header.Hash(), event.Header.Number.Uint64()); header == nil {
+				t.Error("head header not cached")
+			}
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package chainclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/trusted-defi/trusted-engine/log"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math/big"
+	"time"
+)
+
+// ProtocolVersion is the version of the ChainService protocol spoken by the
+// engine. Chain servers reporting an older version are refused.
+const ProtocolVersion = 1
+
+// Features of the ChainService protocol which are negotiated at connect time.
+const (
+	FeatureHeaderStream = "header-stream" // ChainHeaderEvent header-only head stream
+	FeatureBlockRange   = "block-range"   // GetBlocks batched block range fetch
+	FeatureHeaderFetch  = "header-fetch"  // GetHeader single header fetch
+)
+
+// negotiateTimeout is the time allowed for the capability exchange.
+const negotiateTimeout = 10 * time.Second
+
+var (
+	// ErrProtocolVersion is returned if chain server speaks an unsupported protocol.
+	ErrProtocolVersion = errors.New("unsupported chain server protocol version")
+
+	// ErrChainIDMismatch is returned if chain server follows another chain than configured.
+	ErrChainIDMismatch = errors.New("chain id mismatch")
+
+	// ErrGenesisMismatch is returned if chain server follows a chain of another genesis.
+	ErrGenesisMismatch = errors.New("genesis mismatch")
+)
+
+// supportedFeatures is the list of features the engine announces to chain server.
+var supportedFeatures = []string{FeatureHeaderStream, FeatureBlockRange, FeatureHeaderFetch}
+
+// ChainInfo is the chain description and the capabilities of chain server.
+type ChainInfo struct {
+	ProtocolVersion uint32
+	ChainID         *big.Int
+	GenesisHash     common.Hash
+	ChainConfig     *params.ChainConfig // Fork activation config, nil if not provided
+	Features        map[string]bool
+
+	Legacy bool // Chain server predates negotiation, features are probed instead
+}
+
+// Supports reports whether chain server announced the given feature.
+func (info *ChainInfo) Supports(feature string) bool {
+	return info.Features[feature]
+}
+
+// mayUse reports whether the feature is worth trying, which is the case if it is
+// supported or if it is unknown whether it is.
+func (info *ChainInfo) mayUse(feature string) bool {
+	return info == nil || info.Legacy || info.Supports(feature)
+}
+
+func parseChainInfo(res *trusted.ChainInfoResponse) (*ChainInfo, error) {
+	info := &ChainInfo{
+		ProtocolVersion: res.ProtocolVersion,
+		ChainID:         new(big.Int).SetBytes(res.ChainId),
+		GenesisHash:     common.BytesToHash(res.GenesisHash),
+		Features:        make(map[string]bool),
+	}
+	if len(res.ChainConfig) > 0 {
+		info.ChainConfig = new(params.ChainConfig)
+		if err := json.Unmarshal(res.ChainConfig, info.ChainConfig); err != nil {
+			return nil, fmt.Errorf("invalid chain config: %v", err)
+		}
+	}
+	for _, feature := range res.Features {
+		info.Features[feature] = true
+	}
+	return info, nil
+}
+
+// ChainInfo returns the negotiated chain info, or nil if chain server has not
+// been reached yet.
+func (client *ChainClient) ChainInfo() *ChainInfo {
+	client.infoMu.RLock()
+	defer client.infoMu.RUnlock()
+
+	return client.info
+}
+
+// negotiate exchanges the protocol version and capabilities with chain server
+// and checks that it follows the expected chain.
+func (client *ChainClient) negotiate() (*ChainInfo, error) {
+	ctx, cancel := context.WithTimeout(client.ctx, negotiateTimeout)
+	defer cancel()
+
+	req := new(trusted.ChainInfoRequest)
+	req.ProtocolVersion = ProtocolVersion
+	req.Features = supportedFeatures
+	res, err := client.cclient.GetChainInfo(ctx, req)
+	var info *ChainInfo
+	switch {
+	case status.Code(err) == codes.Unimplemented:
+		log.Warn("chain server doesn't support capability negotiation, probe features instead")
+		info = &ChainInfo{Legacy: true, Features: make(map[string]bool)}
+	case err != nil:
+		return nil, err
+	default:
+		if info, err = parseChainInfo(res); err != nil {
+			return nil, err
+		}
+		if err = client.checkChainInfo(info); err != nil {
+			return nil, err
+		}
+		log.WithField("chainid", info.ChainID).WithField("genesis", info.GenesisHash.Hex()).
+			WithField("version", info.ProtocolVersion).WithField("features", res.Features).Info("chain server negotiated")
+	}
+	client.infoMu.Lock()
+	client.info = info
+	client.infoMu.Unlock()
+	return info, nil
+}
+
+// checkChainInfo verifies the chain server is usable by this engine.
+func (client *ChainClient) checkChainInfo(info *ChainInfo) error {
+	if info.ProtocolVersion < ProtocolVersion {
+		return fmt.Errorf("%w: have %d, want %d", ErrProtocolVersion, info.ProtocolVersion, ProtocolVersion)
+	}
+	if client.chainID != nil && client.chainID.Cmp(info.ChainID) != 0 {
+		return fmt.Errorf("%w: configured %v, chain server %v", ErrChainIDMismatch, client.chainID, info.ChainID)
+	}
+	if client.genesis != (common.Hash{}) && info.GenesisHash != client.genesis {
+		return fmt.Errorf("%w: configured %x, chain server %x", ErrGenesisMismatch, client.genesis, info.GenesisHash)
+	}
+	for _, feature := range supportedFeatures {
+		if !info.Supports(feature) {
+			log.WithField("feature", feature).Warn("chain server lacks feature, running degraded")
+		}
+	}
+	return nil
+}
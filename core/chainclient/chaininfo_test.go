@@ -0,0 +1,180 @@
+package chainclient
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	lru "github.com/hashicorp/golang-lru"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math/big"
+	"testing"
+)
+
+// infoServer answers GetChainInfo with a fixed response or error, the other
+// methods of the chain service are not implemented.
+type infoServer struct {
+	trusted.ChainServiceClient
+	res *trusted.ChainInfoResponse
+	err error
+}
+
+func (s *infoServer) GetChainInfo(ctx context.Context, in *trusted.ChainInfoRequest, opts ...grpc.CallOption) (*trusted.ChainInfoResponse, error) {
+	return s.res, s.err
+}
+
+func newInfoClient(server *infoServer, chainID int64, genesis common.Hash) *ChainClient {
+	client := &ChainClient{cclient: server, ctx: context.Background(), genesis: genesis, quit: make(chan struct{})}
+	if chainID != 0 {
+		client.chainID = big.NewInt(chainID)
+	}
+	return client
+}
+
+func TestChainClient_Negotiate(t *testing.T) {
+	genesis := common.HexToHash("0x01")
+	response := func(version uint32, chainID int64, genesis common.Hash) *trusted.ChainInfoResponse {
+		return &trusted.ChainInfoResponse{
+			ProtocolVersion: version,
+			ChainId:         big.NewInt(chainID).Bytes(),
+			GenesisHash:     genesis.Bytes(),
+			Features:        supportedFeatures,
+		}
+	}
+	tests := []struct {
+		name    string
+		server  *infoServer
+		chainID int64
+		genesis common.Hash
+		err     error
+		legacy  bool
+	}{
+		{"match", &infoServer{res: response(ProtocolVersion, 5, genesis)}, 5, genesis, nil, false},
+		{"any chain", &infoServer{res: response(ProtocolVersion, 5, genesis)}, 0, common.Hash{}, nil, false},
+		{"old version", &infoServer{res: response(ProtocolVersion-1, 5, genesis)}, 5, genesis, ErrProtocolVersion, false},
+		{"chain id mismatch", &infoServer{res: response(ProtocolVersion, 6, genesis)}, 5, genesis, ErrChainIDMismatch, false},
+		{"genesis mismatch", &infoServer{res: response(ProtocolVersion, 5, common.HexToHash("0x02"))}, 5, genesis, ErrGenesisMismatch, false},
+		{"legacy", &infoServer{err: status.Error(codes.Unimplemented, "unknown method")}, 5, genesis, nil, true},
+	}
+	for _, tt := range tests {
+		client := newInfoClient(tt.server, tt.chainID, tt.genesis)
+		info, err := client.negotiate()
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%s: error mismatch: have %v, want %v", tt.name, err, tt.err)
+			continue
+		}
+		if err != nil {
+			if client.ChainInfo() != nil {
+				t.Errorf("%s: refused chain info kept", tt.name)
+			}
+			continue
+		}
+		if info.Legacy != tt.legacy || client.ChainInfo() != info {
+			t.Errorf("%s: chain info mismatch: have %+v", tt.name, info)
+		}
+		if !info.mayUse(FeatureHeaderStream) || !info.mayUse(FeatureBlockRange) {
+			t.Errorf("%s: features not usable: %+v", tt.name, info)
+		}
+	}
+}
+
+// Tests that a chain server refused when first reached by the loop, after
+// startup, is reported instead of only logged.
+func TestChainClient_RefusedInLoop(t *testing.T) {
+	server := &infoServer{res: &trusted.ChainInfoResponse{
+		ProtocolVersion: ProtocolVersion,
+		ChainId:         big.NewInt(6).Bytes(),
+	}}
+	client := newInfoClient(server, 5, common.Hash{})
+	if client.Refused() != nil {
+		t.Fatal("refused before negotiation")
+	}
+	client.wg.Add(1)
+	client.loop()
+
+	if err := client.Refused(); !errors.Is(err, ErrChainIDMismatch) {
+		t.Errorf("refusal mismatch: have %v, want %v", err, ErrChainIDMismatch)
+	}
+}
+
+// blockServer serves GetBlock only, like a chain server without header fetches.
+type blockServer struct {
+	infoServer
+	block  *types.Block
+	header int // Number of GetHeader calls
+}
+
+func (s *blockServer) GetHeader(ctx context.Context, in *trusted.HeaderRequest, opts ...grpc.CallOption) (*trusted.HeaderResponse, error) {
+	s.header++
+	return nil, status.Error(codes.Unimplemented, "unknown method")
+}
+
+func (s *blockServer) GetBlock(ctx context.Context, in *trusted.BlockRequest, opts ...grpc.CallOption) (*trusted.BlockResponse, error) {
+	data, err := rlp.EncodeToBytes(s.block)
+	return &trusted.BlockResponse{BlockData: data}, err
+}
+
+// Tests that a header missing from cache falls back to a single block fetch if
+// chain server has no header api, and that only the header is cached.
+func TestChainClient_GetHeaderFallback(t *testing.T) {
+	block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(7), Difficulty: common.Big1})
+	server := &blockServer{block: block}
+	client := newInfoClient(&server.infoServer, 0, common.Hash{})
+	client.cclient = server
+	client.blockCache, _ = lru.New(blockCacheLimit)
+	client.headerCache, _ = lru.New(headerCacheLimit)
+	client.info = &ChainInfo{Features: map[string]bool{FeatureHeaderFetch: true}}
+
+	for i := 0; i < 2; i++ {
+		if header := client.GetHeader(common.Hash{1}, 7); header != nil {
+			t.Fatalf("header with mismatching hash returned: %x", header.Hash())
+		}
+	}
+	if server.header != 1 {
+		t.Errorf("header api calls mismatch: have %d, want 1", server.header)
+	}
+	if header := client.GetHeader(block.Hash(), 7); header == nil || header.Hash() != block.Hash() {
+		t.Fatalf("header mismatch: have %v, want %x", header, block.Hash())
+	}
+	if !client.headerCache.Contains(block.Hash()) || client.blockCache.Len() != 0 {
+		t.Errorf("cache mismatch: header cached %v, blocks cached %d", client.headerCache.Contains(block.Hash()), client.blockCache.Len())
+	}
+}
+
+// emptyServer answers block requests with empty block data.
+type emptyServer struct {
+	infoServer
+}
+
+func (s *emptyServer) CurrentBlock(ctx context.Context, in *trusted.CurrentBlockRequest, opts ...grpc.CallOption) (*trusted.CurrentBlockResponse, error) {
+	return new(trusted.CurrentBlockResponse), nil
+}
+
+func (s *emptyServer) GetBlock(ctx context.Context, in *trusted.BlockRequest, opts ...grpc.CallOption) (*trusted.BlockResponse, error) {
+	return new(trusted.BlockResponse), nil
+}
+
+// Tests that empty block data from chain server is an error or unknown block,
+// and that blocks without a header are not cached.
+func TestChainClient_EmptyBlock(t *testing.T) {
+	server := new(emptyServer)
+	client := newInfoClient(&server.infoServer, 0, common.Hash{})
+	client.cclient = server
+	client.blockCache, _ = lru.New(blockCacheLimit)
+	client.headerCache, _ = lru.New(headerCacheLimit)
+
+	if block, err := client.CurrentBlock(); err == nil {
+		t.Errorf("empty current block accepted: %v", block)
+	}
+	if block := client.GetBlock(common.Hash{1}, 1); block != nil {
+		t.Errorf("empty block returned: %v", block)
+	}
+	client.cacheBlock(new(types.Block))
+	if client.blockCache.Len() != 0 || client.headerCache.Len() != 0 {
+		t.Error("block without a header cached")
+	}
+}
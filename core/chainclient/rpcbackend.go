@@ -0,0 +1,415 @@
+package chainclient
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"math/big"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// rpcPollInterval is the interval new heads are polled at over http, where
+// subscriptions are not available.
+var rpcPollInterval = 2 * time.Second
+
+// rpcHeadChanSize is the size of the channel buffering received heads.
+const rpcHeadChanSize = 16
+
+// rpcWSBufferSize is the buffer size of websocket connections, as used by
+// go-ethereum.
+const rpcWSBufferSize = 1024
+
+// rpcMaxBlockRange is the maximum number of blocks fetched by one GetBlocks.
+const rpcMaxBlockRange = 128
+
+// isRPCURL reports whether the chain server address is an eth JSON-RPC
+// endpoint rather than a ChainService grpc address.
+func isRPCURL(addr string) bool {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return false
+	}
+	switch u.Scheme {
+	case "http", "https", "ws", "wss":
+		return true
+	}
+	return false
+}
+
+// rpcBackend serves the ChainService client api from a standard execution
+// client over eth JSON-RPC, so the rest of ChainClient is unaware of it.
+//
+// Block bodies are rebuilt from their JSON form, so only fields known to this
+// go-ethereum version are preserved.
+type rpcBackend struct {
+	client *rpc.Client
+	eth    *ethclient.Client
+	notify bool // subscriptions are supported by the transport
+}
+
+var _ trusted.ChainServiceClient = (*rpcBackend)(nil)
+
+// dialRPC connects to the JSON-RPC url, over TLS with the given config, which
+// needs an https or wss url.
+func dialRPC(ctx context.Context, rawurl string, tlsConfig *tls.Config) (*rpcBackend, error) {
+	u, _ := url.Parse(rawurl)
+	var (
+		client *rpc.Client
+		err    error
+	)
+	switch {
+	case tlsConfig == nil:
+		client, err = rpc.DialContext(ctx, rawurl)
+	case u.Scheme == "https":
+		client, err = rpc.DialHTTPWithClient(rawurl, &http.Client{
+			Transport: &http.Transport{TLSClientConfig: tlsConfig, Proxy: http.ProxyFromEnvironment},
+		})
+	case u.Scheme == "wss":
+		client, err = rpc.DialWebsocketWithDialer(ctx, rawurl, "", websocket.Dialer{
+			ReadBufferSize:  rpcWSBufferSize,
+			WriteBufferSize: rpcWSBufferSize,
+			TLSClientConfig: tlsConfig,
+			Proxy:           http.ProxyFromEnvironment,
+		})
+	default:
+		return nil, fmt.Errorf("chain server tls needs an https or wss url, have %s", u.Scheme)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rpcBackend{
+		client: client,
+		eth:    ethclient.NewClient(client),
+		notify: u.Scheme == "ws" || u.Scheme == "wss",
+	}, nil
+}
+
+// rpcStatus converts a JSON-RPC error to the grpc status the client expects.
+func rpcStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		if rpcErr.ErrorCode() == -32601 {
+			return status.Error(codes.Unimplemented, err.Error())
+		}
+		return status.Error(codes.Unknown, err.Error())
+	}
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, err.Error())
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	return status.Error(codes.Unavailable, err.Error())
+}
+
+// rpcBlockNumber parses the optional big endian block number of a request.
+func rpcBlockNumber(number []byte) *big.Int {
+	if len(number) == 0 {
+		return nil
+	}
+	return new(big.Int).SetBytes(number)
+}
+
+func (b *rpcBackend) ServiceReady(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*trusted.ServiceReadyResponse, error) {
+	if _, err := b.eth.BlockNumber(ctx); err != nil {
+		return nil, rpcStatus(err)
+	}
+	return &trusted.ServiceReadyResponse{Ready: true}, nil
+}
+
+// GetChainInfo reports the chain id and genesis, the chain config is not
+// available over JSON-RPC and has to be configured locally.
+func (b *rpcBackend) GetChainInfo(ctx context.Context, in *trusted.ChainInfoRequest, opts ...grpc.CallOption) (*trusted.ChainInfoResponse, error) {
+	chainID, err := b.eth.ChainID(ctx)
+	if err != nil {
+		return nil, rpcStatus(err)
+	}
+	genesis, err := b.eth.HeaderByNumber(ctx, common.Big0)
+	if err != nil {
+		return nil, rpcStatus(err)
+	}
+	res := new(trusted.ChainInfoResponse)
+	res.ProtocolVersion = ProtocolVersion
+	res.ChainId = chainID.Bytes()
+	res.GenesisHash = genesis.Hash().Bytes()
+	res.Features = supportedFeatures
+	return res, nil
+}
+
+func (b *rpcBackend) GetBlock(ctx context.Context, in *trusted.BlockRequest, opts ...grpc.CallOption) (*trusted.BlockResponse, error) {
+	var (
+		block *types.Block
+		err   error
+	)
+	if len(in.BlockHash) > 0 {
+		block, err = b.eth.BlockByHash(ctx, common.BytesToHash(in.BlockHash))
+	} else {
+		block, err = b.eth.BlockByNumber(ctx, new(big.Int).SetUint64(in.BlockNum))
+	}
+	res := new(trusted.BlockResponse)
+	if errors.Is(err, ethereum.NotFound) {
+		return res, nil
+	}
+	if err != nil {
+		return nil, rpcStatus(err)
+	}
+	if res.BlockData, err = rlp.EncodeToBytes(block); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return res, nil
+}
+
+func (b *rpcBackend) GetBlocks(ctx context.Context, in *trusted.BlockRangeRequest, opts ...grpc.CallOption) (*trusted.BlocksResponse, error) {
+	count := in.Count
+	if count > rpcMaxBlockRange {
+		count = rpcMaxBlockRange
+	}
+	res := new(trusted.BlocksResponse)
+	hash := common.BytesToHash(in.BlockHash)
+	for i := uint64(0); i < count; i++ {
+		block, err := b.eth.BlockByHash(ctx, hash)
+		if errors.Is(err, ethereum.NotFound) {
+			break
+		}
+		if err != nil {
+			return nil, rpcStatus(err)
+		}
+		data, err := rlp.EncodeToBytes(block)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.BlockData = append(res.BlockData, data)
+		if block.NumberU64() == 0 {
+			break
+		}
+		hash = block.ParentHash()
+	}
+	return res, nil
+}
+
+func (b *rpcBackend) GetHeader(ctx context.Context, in *trusted.HeaderRequest, opts ...grpc.CallOption) (*trusted.HeaderResponse, error) {
+	var (
+		header *types.Header
+		err    error
+	)
+	if len(in.BlockHash) > 0 {
+		header, err = b.eth.HeaderByHash(ctx, common.BytesToHash(in.BlockHash))
+	} else {
+		header, err = b.eth.HeaderByNumber(ctx, new(big.Int).SetUint64(in.BlockNum))
+	}
+	res := new(trusted.HeaderResponse)
+	if errors.Is(err, ethereum.NotFound) {
+		return res, nil
+	}
+	if err != nil {
+		return nil, rpcStatus(err)
+	}
+	if res.HeaderData, err = rlp.EncodeToBytes(header); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return res, nil
+}
+
+func (b *rpcBackend) GetBalance(ctx context.Context, in *trusted.BalanceRequest, opts ...grpc.CallOption) (*trusted.BalanceResponse, error) {
+	balance, err := b.eth.BalanceAt(ctx, common.BytesToAddress(in.Address), rpcBlockNumber(in.BlockNum))
+	if err != nil {
+		return nil, rpcStatus(err)
+	}
+	return &trusted.BalanceResponse{Balance: balance.Bytes()}, nil
+}
+
+func (b *rpcBackend) GetNonce(ctx context.Context, in *trusted.NonceRequest, opts ...grpc.CallOption) (*trusted.NonceResponse, error) {
+	nonce, err := b.eth.NonceAt(ctx, common.BytesToAddress(in.Address), rpcBlockNumber(in.BlockNum))
+	if err != nil {
+		return nil, rpcStatus(err)
+	}
+	return &trusted.NonceResponse{Nonce: nonce}, nil
+}
+
+func (b *rpcBackend) CurrentBlock(ctx context.Context, in *trusted.CurrentBlockRequest, opts ...grpc.CallOption) (*trusted.CurrentBlockResponse, error) {
+	block, err := b.eth.BlockByNumber(ctx, nil)
+	if err != nil {
+		return nil, rpcStatus(err)
+	}
+	data, err := rlp.EncodeToBytes(block)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &trusted.CurrentBlockResponse{BlockData: data}, nil
+}
+
+func (b *rpcBackend) LatestHeader(ctx context.Context, in *trusted.LatestHeaderRequest, opts ...grpc.CallOption) (*trusted.LatestHeaderResponse, error) {
+	header, err := b.eth.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, rpcStatus(err)
+	}
+	data, err := json.Marshal(header)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &trusted.LatestHeaderResponse{BlockNum: header.Number.Bytes(), HeaderJson: data}, nil
+}
+
+func (b *rpcBackend) ChainHeadEvent(ctx context.Context, in *trusted.ChainHeadEventRequest, opts ...grpc.CallOption) (trusted.ChainService_ChainHeadEventClient, error) {
+	heads, err := b.subscribeHeads(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcBlockStream{rpcHeadStream: heads, eth: b.eth}, nil
+}
+
+func (b *rpcBackend) ChainHeaderEvent(ctx context.Context, in *trusted.ChainHeaderEventRequest, opts ...grpc.CallOption) (trusted.ChainService_ChainHeaderEventClient, error) {
+	heads, err := b.subscribeHeads(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcHeaderStream{rpcHeadStream: heads}, nil
+}
+
+// subscribeHeads follows the chain head, with newHeads if the transport
+// supports subscriptions and by polling the latest header otherwise.
+func (b *rpcBackend) subscribeHeads(ctx context.Context) (*rpcHeadStream, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &rpcHeadStream{
+		ctx:    ctx,
+		cancel: cancel,
+		heads:  make(chan *types.Header, rpcHeadChanSize),
+		errc:   make(chan error, 1),
+	}
+	if b.notify {
+		sub, err := b.eth.SubscribeNewHead(ctx, s.heads)
+		if err != nil {
+			cancel()
+			return nil, rpcStatus(err)
+		}
+		go func() {
+			defer sub.Unsubscribe()
+			select {
+			case err := <-sub.Err():
+				s.errc <- rpcStatus(err)
+			case <-ctx.Done():
+			}
+		}()
+		return s, nil
+	}
+	go b.pollHeads(s)
+	return s, nil
+}
+
+// pollHeads posts the latest header whenever it changes.
+func (b *rpcBackend) pollHeads(s *rpcHeadStream) {
+	ticker := time.NewTicker(rpcPollInterval)
+	defer ticker.Stop()
+
+	var last common.Hash
+	for {
+		header, err := b.eth.HeaderByNumber(s.ctx, nil)
+		if err != nil {
+			s.errc <- rpcStatus(err)
+			return
+		}
+		if hash := header.Hash(); hash != last {
+			last = hash
+			select {
+			case s.heads <- header:
+			case <-s.ctx.Done():
+				return
+			}
+		}
+		select {
+		case <-ticker.C:
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// rpcHeadStream is the client stream of heads followed over JSON-RPC.
+type rpcHeadStream struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	heads  chan *types.Header
+	errc   chan error
+}
+
+func (s *rpcHeadStream) next() (*types.Header, error) {
+	select {
+	case header := <-s.heads:
+		return header, nil
+	case err := <-s.errc:
+		s.cancel()
+		return nil, err
+	case <-s.ctx.Done():
+		return nil, rpcStatus(s.ctx.Err())
+	}
+}
+
+func (s *rpcHeadStream) Header() (metadata.MD, error) { return nil, nil }
+func (s *rpcHeadStream) Trailer() metadata.MD         { return nil }
+func (s *rpcHeadStream) Context() context.Context     { return s.ctx }
+func (s *rpcHeadStream) SendMsg(m interface{}) error {
+	return errors.New("send on receive only stream")
+}
+func (s *rpcHeadStream) RecvMsg(m interface{}) error { return errors.New("raw receive not supported") }
+
+func (s *rpcHeadStream) CloseSend() error {
+	s.cancel()
+	return nil
+}
+
+type rpcHeaderStream struct {
+	*rpcHeadStream
+}
+
+func (s *rpcHeaderStream) Recv() (*trusted.ChainHeaderEventResponse, error) {
+	header, err := s.next()
+	if err != nil {
+		return nil, err
+	}
+	data, err := rlp.EncodeToBytes(header)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &trusted.ChainHeaderEventResponse{HeaderData: data}, nil
+}
+
+type rpcBlockStream struct {
+	*rpcHeadStream
+	eth *ethclient.Client
+}
+
+func (s *rpcBlockStream) Recv() (*trusted.ChainHeadEventResponse, error) {
+	header, err := s.next()
+	if err != nil {
+		return nil, err
+	}
+	block, err := s.eth.BlockByHash(s.ctx, header.Hash())
+	if err != nil {
+		return nil, rpcStatus(err)
+	}
+	data, err := rlp.EncodeToBytes(block)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &trusted.ChainHeadEventResponse{BlockData: data}, nil
+}
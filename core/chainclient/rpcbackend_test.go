@@ -0,0 +1,284 @@
+package chainclient
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/trusted-defi/trusted-engine/config"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+var (
+	rpcTestKey, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	rpcTestAddr   = crypto.PubkeyToAddress(rpcTestKey.PublicKey)
+)
+
+// testEthService is a stand-in for the eth namespace of an execution client,
+// serving a fixed chain which can be extended by the test.
+type testEthService struct {
+	mu     sync.Mutex
+	blocks []*types.Block // canonical chain, indexed by number
+	heads  event.Feed
+}
+
+func newTestEthService(t *testing.T, n int) *testEthService {
+	db := rawdb.NewMemoryDatabase()
+	gspec := &core.Genesis{
+		Config: params.TestChainConfig,
+		Alloc:  core.GenesisAlloc{rpcTestAddr: {Balance: big.NewInt(1e18)}},
+	}
+	genesis := gspec.MustCommit(db)
+	signer := types.LatestSigner(gspec.Config)
+	blocks, _ := core.GenerateChain(gspec.Config, genesis, ethash.NewFaker(), db, n, func(i int, gen *core.BlockGen) {
+		tx, _ := types.SignTx(types.NewTransaction(uint64(i), common.Address{1}, big.NewInt(1), params.TxGas, gen.BaseFee(), nil), signer, rpcTestKey)
+		gen.AddTx(tx)
+	})
+	return &testEthService{blocks: append([]*types.Block{genesis}, blocks...)}
+}
+
+func (s *testEthService) head() *types.Block {
+	return s.blocks[len(s.blocks)-1]
+}
+
+// extend appends the block as new head and announces it.
+func (s *testEthService) extend(block *types.Block) {
+	s.mu.Lock()
+	s.blocks = append(s.blocks, block)
+	s.mu.Unlock()
+	s.heads.Send(block.Header())
+}
+
+func marshalRPCBlock(block *types.Block) (map[string]interface{}, error) {
+	data, err := json.Marshal(block.Header())
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	fields["transactions"] = block.Transactions()
+	fields["uncles"] = []common.Hash{}
+	return fields, nil
+}
+
+func (s *testEthService) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(params.TestChainConfig.ChainID)
+}
+
+func (s *testEthService) BlockNumber() hexutil.Uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return hexutil.Uint64(s.head().NumberU64())
+}
+
+func (s *testEthService) GetBlockByHash(hash common.Hash, full bool) (map[string]interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, block := range s.blocks {
+		if block.Hash() == hash {
+			return marshalRPCBlock(block)
+		}
+	}
+	return nil, nil
+}
+
+func (s *testEthService) GetBlockByNumber(number rpc.BlockNumber, full bool) (map[string]interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if number < 0 {
+		return marshalRPCBlock(s.head())
+	}
+	if int(number) >= len(s.blocks) {
+		return nil, nil
+	}
+	return marshalRPCBlock(s.blocks[number])
+}
+
+func (s *testEthService) GetBalance(addr common.Address, number rpc.BlockNumberOrHash) *hexutil.Big {
+	if addr == rpcTestAddr {
+		return (*hexutil.Big)(big.NewInt(1e18))
+	}
+	return new(hexutil.Big)
+}
+
+func (s *testEthService) GetTransactionCount(addr common.Address, number rpc.BlockNumberOrHash) hexutil.Uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if addr != rpcTestAddr {
+		return 0
+	}
+	if num, ok := number.Number(); ok && num >= 0 {
+		return hexutil.Uint64(num)
+	}
+	return hexutil.Uint64(s.head().NumberU64())
+}
+
+func (s *testEthService) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	sub := notifier.CreateSubscription()
+	heads := make(chan *types.Header, 4)
+	headSub := s.heads.Subscribe(heads)
+	go func() {
+		defer headSub.Unsubscribe()
+		for {
+			select {
+			case header := <-heads:
+				notifier.Notify(sub.ID, header)
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+	return sub, nil
+}
+
+// newTestRPCServer serves the stand-in over http and websocket.
+func newTestRPCServer(t *testing.T, eth *testEthService) (httpURL, wsURL string) {
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", eth); err != nil {
+		t.Fatalf("register eth service failed: %v", err)
+	}
+	httpSrv := httptest.NewServer(server)
+	wsSrv := httptest.NewServer(server.WebsocketHandler([]string{"*"}))
+	t.Cleanup(func() {
+		httpSrv.Close()
+		wsSrv.Close()
+		server.Stop()
+	})
+	return httpSrv.URL, "ws" + strings.TrimPrefix(wsSrv.URL, "http")
+}
+
+func TestRPCBackend_Methods(t *testing.T) {
+	eth := newTestEthService(t, 6)
+	httpURL, _ := newTestRPCServer(t, eth)
+	client, err := NewChainClient(config.NodeConfig{ChainServer: httpURL, ChainID: params.TestChainConfig.ChainID.Uint64()})
+	if err != nil {
+		t.Fatalf("new chain client failed: %v", err)
+	}
+
+	info := client.ChainInfo()
+	if info == nil || info.GenesisHash != eth.blocks[0].Hash() {
+		t.Fatalf("chain info mismatch: %+v", info)
+	}
+	head := eth.head()
+	if block, err := client.CurrentBlock(); err != nil || block.Hash() != head.Hash() {
+		t.Errorf("current block mismatch: have %v, want %x, err %v", block, head.Hash(), err)
+	}
+	if header, err := client.CurrentHeader(); err != nil || header.Hash() != head.Hash() {
+		t.Errorf("current header mismatch: have %v, want %x, err %v", header, head.Hash(), err)
+	}
+	want := eth.blocks[2]
+	if block := client.GetBlock(want.Hash(), 2); block == nil || block.Hash() != want.Hash() {
+		t.Errorf("block 2 mismatch: have %v, want %x", block, want.Hash())
+	}
+	if header := client.GetHeader(eth.blocks[3].Hash(), 3); header == nil || header.Hash() != eth.blocks[3].Hash() {
+		t.Errorf("header 3 mismatch: have %v, want %x", header, eth.blocks[3].Hash())
+	}
+	blocks := client.GetBlocks(head.Hash(), head.NumberU64(), 4)
+	if len(blocks) != 4 {
+		t.Fatalf("block range length mismatch: have %d, want 4", len(blocks))
+	}
+	for i, block := range blocks {
+		if block.Hash() != eth.blocks[int(head.NumberU64())-i].Hash() {
+			t.Errorf("block range %d mismatch", i)
+		}
+	}
+	if balance := client.GetBalance(rpcTestAddr); balance.Cmp(big.NewInt(1e18)) != 0 {
+		t.Errorf("balance mismatch: have %v", balance)
+	}
+	if nonce := client.NonceAt(rpcTestAddr); nonce != head.NumberU64() {
+		t.Errorf("nonce mismatch: have %d, want %d", nonce, head.NumberU64())
+	}
+	if nonce := client.NonceAtHeight(rpcTestAddr, big.NewInt(3)); nonce != 3 {
+		t.Errorf("nonce at 3 mismatch: have %d, want 3", nonce)
+	}
+}
+
+func testRPCHeads(t *testing.T, url string, eth *testEthService, next *types.Block) {
+	client, err := NewChainClient(config.NodeConfig{ChainServer: url})
+	if err != nil {
+		t.Fatalf("new chain client failed: %v", err)
+	}
+	headers := make(chan ChainHeaderEvent, 4)
+	sub := client.SubscribeChainHeaderEvent(headers)
+	defer sub.Unsubscribe()
+
+	// Wait for the head stream to be set up before announcing the new head.
+	time.Sleep(200 * time.Millisecond)
+	eth.extend(next)
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case ev := <-headers:
+			if ev.Header.Hash() == next.Hash() {
+				return
+			}
+		case <-timeout:
+			t.Fatal("new head not received")
+		}
+	}
+}
+
+func TestRPCBackend_PollHeads(t *testing.T) {
+	defer func(interval time.Duration) { rpcPollInterval = interval }(rpcPollInterval)
+	rpcPollInterval = 50 * time.Millisecond
+
+	// Chains are generated deterministically, so a longer one extends it.
+	eth := newTestEthService(t, 3)
+	next := newTestEthService(t, 4).head()
+	httpURL, _ := newTestRPCServer(t, eth)
+	testRPCHeads(t, httpURL, eth, next)
+}
+
+func TestRPCBackend_SubscribeHeads(t *testing.T) {
+	// Chains are generated deterministically, so a longer one extends it.
+	eth := newTestEthService(t, 3)
+	next := newTestEthService(t, 4).head()
+	_, wsURL := newTestRPCServer(t, eth)
+	testRPCHeads(t, wsURL, eth, next)
+}
+
+func TestRPCBackend_Close(t *testing.T) {
+	eth := newTestEthService(t, 3)
+	_, wsURL := newTestRPCServer(t, eth)
+	client, err := NewChainClient(config.NodeConfig{ChainServer: wsURL})
+	if err != nil {
+		t.Fatalf("new chain client failed: %v", err)
+	}
+	sub := client.SubscribeChainHeaderEvent(make(chan ChainHeaderEvent))
+	for deadline := time.Now().Add(5 * time.Second); !client.Connected(); time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("head stream not connected")
+		}
+	}
+
+	client.Close()
+	if client.Connected() {
+		t.Error("head stream still connected after close")
+	}
+	select {
+	case <-sub.Err():
+	case <-time.After(time.Second):
+		t.Fatal("subscription not ended by close")
+	}
+	client.Close()
+}
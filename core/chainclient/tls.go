@@ -0,0 +1,150 @@
+package chainclient
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/trusted-defi/trusted-engine/config"
+	"github.com/trusted-defi/trusted-engine/log"
+	"net"
+	"net/url"
+	"os"
+)
+
+// ErrTLSVerification is returned when the chain server certificate is rejected.
+var ErrTLSVerification = errors.New("chain server tls verification failed")
+
+// tlsState records the outcome of the latest chain server handshake.
+type tlsState struct {
+	err error
+}
+
+// clientTLSConfig returns the TLS config of the chain server connection, nil
+// to connect in plaintext. Verification is done by the config itself, so each
+// outcome can be passed to onVerify.
+func clientTLSConfig(conf config.ChainTLSConfig, addr string, onVerify func(error)) (*tls.Config, error) {
+	if !conf.On() {
+		return nil, nil
+	}
+	serverName := conf.ServerName
+	if serverName == "" {
+		serverName = addressHost(addr)
+	}
+	tlsConfig := &tls.Config{
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+		// Verified in VerifyConnection instead, to record why it failed
+		InsecureSkipVerify: true,
+	}
+	var (
+		roots  *x509.CertPool
+		pinned []*x509.Certificate
+		err    error
+	)
+	if conf.CA != "" {
+		if roots, err = loadCertPool(conf.CA); err != nil {
+			return nil, err
+		}
+	}
+	if conf.ServerCert != "" {
+		if pinned, err = loadCerts(conf.ServerCert); err != nil {
+			return nil, err
+		}
+	}
+	if conf.Cert != "" {
+		cert, err := tls.LoadX509KeyPair(conf.Cert, conf.Key)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	tlsConfig.VerifyConnection = func(cs tls.ConnectionState) error {
+		err := verifyServer(cs.PeerCertificates, roots, pinned, serverName)
+		if err != nil {
+			err = fmt.Errorf("%w: %v", ErrTLSVerification, err)
+			log.Warn("chain server rejected", "err", err)
+		}
+		onVerify(err)
+		return err
+	}
+	return tlsConfig, nil
+}
+
+// verifyServer checks the chain server certificate chain. A pinned certificate
+// is trusted as is, otherwise the chain has to lead to the roots and be issued
+// for the server name.
+func verifyServer(certs []*x509.Certificate, roots *x509.CertPool, pinned []*x509.Certificate, serverName string) error {
+	if len(certs) == 0 {
+		return errors.New("no certificate")
+	}
+	if len(pinned) > 0 {
+		for _, cert := range pinned {
+			if bytes.Equal(cert.Raw, certs[0].Raw) {
+				return nil
+			}
+		}
+		return errors.New("certificate not pinned")
+	}
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		DNSName:       serverName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(opts)
+	return err
+}
+
+// addressHost returns the host of a grpc address or url.
+func addressHost(addr string) string {
+	if u, err := url.Parse(addr); err == nil && u.Host != "" {
+		return u.Hostname()
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+func loadCertPool(file string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificate in %s", file)
+	}
+	return pool, nil
+}
+
+func loadCerts(file string) ([]*x509.Certificate, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		if block, data = pem.Decode(data); block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificate in %s", file)
+	}
+	return certs, nil
+}
@@ -0,0 +1,192 @@
+package chainclient
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/trusted-defi/trusted-engine/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"math/big"
+	"net"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testCert is a self-signed certificate for localhost and its PEM files.
+type testCert struct {
+	cert     tls.Certificate
+	certFile string
+	keyFile  string
+}
+
+func newTestCert(t *testing.T, name string) *testCert {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &priv.PublicKey, priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &testCert{
+		cert:     tls.Certificate{Certificate: [][]byte{der}, PrivateKey: priv},
+		certFile: filepath.Join(t.TempDir(), name+".crt"),
+		keyFile:  filepath.Join(t.TempDir(), name+".key"),
+	}
+	if err := os.WriteFile(c.certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(c.keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// newTestTLSRPCServer serves the eth stand-in over https and wss.
+func newTestTLSRPCServer(t *testing.T, eth *testEthService, cert *testCert) (httpsURL, wssURL string) {
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", eth); err != nil {
+		t.Fatalf("register eth service failed: %v", err)
+	}
+	httpSrv := httptest.NewUnstartedServer(server)
+	wsSrv := httptest.NewUnstartedServer(server.WebsocketHandler([]string{"*"}))
+	for _, srv := range []*httptest.Server{httpSrv, wsSrv} {
+		srv.TLS = &tls.Config{Certificates: []tls.Certificate{cert.cert}}
+		srv.StartTLS()
+	}
+	t.Cleanup(func() {
+		httpSrv.Close()
+		wsSrv.Close()
+		server.Stop()
+	})
+	return httpSrv.URL, "wss" + strings.TrimPrefix(wsSrv.URL, "https")
+}
+
+func TestChainClient_TLSPinned(t *testing.T) {
+	eth := newTestEthService(t, 3)
+	server, other := newTestCert(t, "server"), newTestCert(t, "other")
+	httpsURL, wssURL := newTestTLSRPCServer(t, eth, server)
+	chainID := params.TestChainConfig.ChainID.Uint64()
+
+	for _, url := range []string{httpsURL, wssURL} {
+		client, err := NewChainClient(config.NodeConfig{ChainServer: url, ChainID: chainID, ChainTLS: config.ChainTLSConfig{ServerCert: server.certFile}})
+		if err != nil {
+			t.Fatalf("%s: new chain client failed: %v", url, err)
+		}
+		if block, err := client.CurrentBlock(); err != nil || block.Hash() != eth.head().Hash() {
+			t.Errorf("%s: current block mismatch: have %v, err %v", url, block, err)
+		}
+		if err := client.TLSError(); err != nil {
+			t.Errorf("%s: tls error of pinned server: %v", url, err)
+		}
+		client.Close()
+
+		// Websockets fail to dial, over http the client is kept to retry
+		client, err = NewChainClient(config.NodeConfig{ChainServer: url, ChainID: chainID, ChainTLS: config.ChainTLSConfig{ServerCert: other.certFile}})
+		if err == nil {
+			err = client.TLSError()
+			client.Close()
+		}
+		if err == nil || !strings.Contains(err.Error(), ErrTLSVerification.Error()) {
+			t.Errorf("%s: server with other certificate accepted, err %v", url, err)
+		}
+	}
+	// Without a root the certificate is verified against the system roots
+	if _, err := NewChainClient(config.NodeConfig{ChainServer: wssURL, ChainTLS: config.ChainTLSConfig{Enabled: true}}); err == nil {
+		t.Error("self-signed server accepted by system roots")
+	}
+	plainURL := "ws" + strings.TrimPrefix(wssURL, "wss")
+	if _, err := NewChainClient(config.NodeConfig{ChainServer: plainURL, ChainTLS: config.ChainTLSConfig{ServerCert: server.certFile}}); err == nil {
+		t.Error("plaintext url accepted with tls config")
+	}
+}
+
+func TestChainClient_TLSMutual(t *testing.T) {
+	server, clientCert, other := newTestCert(t, "server"), newTestCert(t, "client"), newTestCert(t, "other")
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(mustParse(t, clientCert.cert.Certificate[0]))
+
+	// No services registered, the client falls back to legacy probing once connected
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	grpcServer := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{server.cert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	})))
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+	addr := lis.Addr().String()
+
+	client, err := NewChainClient(config.NodeConfig{ChainServer: addr, ChainTLS: config.ChainTLSConfig{
+		CA:   server.certFile,
+		Cert: clientCert.certFile,
+		Key:  clientCert.keyFile,
+	}})
+	if err != nil {
+		t.Fatalf("new chain client failed: %v", err)
+	}
+	if info := client.ChainInfo(); info == nil || !info.Legacy {
+		t.Errorf("chain server not reached: %+v", info)
+	}
+	if err := client.TLSError(); err != nil {
+		t.Errorf("tls error of trusted server: %v", err)
+	}
+	client.Close()
+
+	// A server of another CA is unreachable, and the client tells why
+	client, err = NewChainClient(config.NodeConfig{ChainServer: addr, ChainTLS: config.ChainTLSConfig{
+		CA:   other.certFile,
+		Cert: clientCert.certFile,
+		Key:  clientCert.keyFile,
+	}})
+	if err != nil {
+		t.Fatalf("new chain client failed: %v", err)
+	}
+	defer client.Close()
+	if info := client.ChainInfo(); info != nil {
+		t.Errorf("untrusted chain server negotiated: %+v", info)
+	}
+	if err := client.TLSError(); !errors.Is(err, ErrTLSVerification) {
+		t.Errorf("tls error mismatch: have %v, want %v", err, ErrTLSVerification)
+	}
+}
+
+func mustParse(t *testing.T, der []byte) *x509.Certificate {
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
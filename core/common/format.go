@@ -21,20 +21,32 @@ func ParseBlockHeader(response *trusted.LatestHeaderResponse) *types.Header {
 	return header
 }
 
+// ParseBlockData decodes an rlp encoded block, it returns nil if the data is
+// empty or invalid, never a block without a header.
 func ParseBlockData(blockdata []byte) *types.Block {
+	if len(blockdata) == 0 {
+		return nil
+	}
 	var block = new(types.Block)
-	if len(blockdata) > 0 {
-		buffer := bytes.NewBuffer(make([]byte, 0))
-		buffer.Write(blockdata)
-		err := rlp.Decode(buffer, &block)
-		if err != nil {
-			log.WithField("error", err).Error("parse block failed")
-			return nil
-		}
+	buffer := bytes.NewBuffer(make([]byte, 0))
+	buffer.Write(blockdata)
+	err := rlp.Decode(buffer, &block)
+	if err != nil {
+		log.WithField("error", err).Error("parse block failed")
+		return nil
 	}
 	return block
 }
 
+func ParseHeaderData(headerdata []byte) *types.Header {
+	var header = new(types.Header)
+	if err := rlp.DecodeBytes(headerdata, header); err != nil {
+		log.WithField("error", err).Error("parse header failed")
+		return nil
+	}
+	return header
+}
+
 func ParseBalance(response *trusted.BalanceResponse) *big.Int {
 	v := new(big.Int)
 	v.SetBytes(response.Balance)
@@ -45,8 +57,10 @@ func ParseNonce(response *trusted.NonceResponse) uint64 {
 	return response.Nonce
 }
 
-func ParseTxData(rlptx []byte) *types.Transaction {
+func ParseTxData(rlptx []byte) (*types.Transaction, error) {
 	tx := new(types.Transaction)
-	tx.UnmarshalBinary(rlptx)
-	return tx
+	if err := tx.UnmarshalBinary(rlptx); err != nil {
+		return nil, err
+	}
+	return tx, nil
 }
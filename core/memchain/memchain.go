@@ -0,0 +1,275 @@
+// Package memchain implements an in-memory chain whose heads, reorgs and
+// account state are scripted, to drive the pool without a chain server.
+package memchain
+
+import (
+	"encoding/binary"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/trusted-defi/trusted-engine/core/chainclient"
+	"math/big"
+	"sync"
+)
+
+// Chain is an in-memory chain. Blocks only link headers and transactions, the
+// account state is a single state database which is scripted independently of
+// the blocks and served at every height.
+type Chain struct {
+	config *params.ChainConfig
+
+	mu       sync.RWMutex
+	statedb  *state.StateDB
+	gasLimit uint64
+	blocks   map[common.Hash]*types.Block
+	head     *types.Block
+	info     *chainclient.ChainInfo
+	seq      uint64 // Makes sibling blocks with the same content unique
+
+	headFeed  event.Feed
+	headScope event.SubscriptionScope
+}
+
+// New creates a chain holding only a genesis block with the given gas limit. If
+// statedb is nil an empty state is used.
+func New(config *params.ChainConfig, gasLimit uint64, statedb *state.StateDB) *Chain {
+	if statedb == nil {
+		statedb, _ = state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	}
+	c := &Chain{
+		config:   config,
+		statedb:  statedb,
+		gasLimit: gasLimit,
+		blocks:   make(map[common.Hash]*types.Block),
+	}
+	genesis := &types.Header{
+		Number:     new(big.Int),
+		GasLimit:   gasLimit,
+		Difficulty: big.NewInt(1),
+	}
+	if config.IsLondon(genesis.Number) {
+		genesis.BaseFee = big.NewInt(params.InitialBaseFee)
+	}
+	c.head = types.NewBlockWithHeader(genesis)
+	c.blocks[c.head.Hash()] = c.head
+	return c
+}
+
+// Config returns the chain config.
+func (c *Chain) Config() *params.ChainConfig {
+	return c.config
+}
+
+// State returns the state database served at every height. Changes made to it
+// directly are not synchronized with the readers.
+func (c *Chain) State() *state.StateDB {
+	return c.statedb
+}
+
+// AddBalance adds amount to the balance of the account.
+func (c *Chain) AddBalance(addr common.Address, amount *big.Int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.statedb.AddBalance(addr, amount)
+}
+
+// SetBalance sets the balance of the account.
+func (c *Chain) SetBalance(addr common.Address, amount *big.Int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.statedb.SetBalance(addr, amount)
+}
+
+// SetNonce sets the nonce of the account.
+func (c *Chain) SetNonce(addr common.Address, nonce uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.statedb.SetNonce(addr, nonce)
+}
+
+// SetGasLimit replaces the head by a copy with the given gas limit, which is
+// also used for all blocks created later. No head event is posted.
+func (c *Chain) SetGasLimit(gasLimit uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.gasLimit = gasLimit
+	header := c.head.Header()
+	header.GasLimit = gasLimit
+	c.head = c.head.WithSeal(header)
+	c.blocks[c.head.Hash()] = c.head
+}
+
+// SetChainInfo sets the chain info reported to the pool.
+func (c *Chain) SetChainInfo(info *chainclient.ChainInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.info = info
+}
+
+// Genesis returns the first block of the chain.
+func (c *Chain) Genesis() *types.Block {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	block := c.head
+	for block.NumberU64() > 0 {
+		block = c.blocks[block.ParentHash()]
+	}
+	return block
+}
+
+// CurrentBlock returns the head block.
+func (c *Chain) CurrentBlock() *types.Block {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.head
+}
+
+// NewBlock creates a child block of parent with the given transactions without
+// inserting it.
+func (c *Chain) NewBlock(parent *types.Block, txs types.Transactions) *types.Block {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.seq++
+	extra := make([]byte, 8)
+	binary.BigEndian.PutUint64(extra, c.seq)
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Number:     new(big.Int).Add(parent.Number(), common.Big1),
+		GasLimit:   c.gasLimit,
+		Time:       parent.Time() + 1,
+		Difficulty: big.NewInt(1),
+		Extra:      extra,
+	}
+	if c.config.IsLondon(header.Number) {
+		header.BaseFee = misc.CalcBaseFee(c.config, parent.Header())
+	}
+	return types.NewBlock(header, txs, nil, nil, trie.NewStackTrie(nil))
+}
+
+// Insert adds the blocks to the chain without changing the head.
+func (c *Chain) Insert(blocks ...*types.Block) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, block := range blocks {
+		c.blocks[block.Hash()] = block
+	}
+}
+
+// SetHead inserts the block, makes it the head and announces it.
+func (c *Chain) SetHead(block *types.Block) {
+	c.mu.Lock()
+	c.blocks[block.Hash()] = block
+	c.head = block
+	c.mu.Unlock()
+
+	c.headFeed.Send(chainclient.ChainHeaderEvent{Header: block.Header()})
+}
+
+// Extend adds a block with the given transactions on top of the head.
+func (c *Chain) Extend(txs ...*types.Transaction) *types.Block {
+	block := c.NewBlock(c.CurrentBlock(), txs)
+	c.SetHead(block)
+	return block
+}
+
+// Reorg drops the last depth blocks and builds a new branch with one block per
+// transaction list on the remaining head. The new head is announced once.
+func (c *Chain) Reorg(depth int, txs ...types.Transactions) *types.Block {
+	c.mu.RLock()
+	parent := c.head
+	for i := 0; i < depth && parent.NumberU64() > 0; i++ {
+		parent = c.blocks[parent.ParentHash()]
+	}
+	c.mu.RUnlock()
+
+	if len(txs) == 0 {
+		txs = []types.Transactions{nil}
+	}
+	for _, list := range txs {
+		parent = c.NewBlock(parent, list)
+		c.Insert(parent)
+	}
+	c.SetHead(parent)
+	return parent
+}
+
+// CurrentHeader returns the header of the head block.
+func (c *Chain) CurrentHeader() (*types.Header, error) {
+	return c.CurrentBlock().Header(), nil
+}
+
+// GetHeader returns the header by hash and number, nil if unknown.
+func (c *Chain) GetHeader(hash common.Hash, number uint64) *types.Header {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if block := c.blocks[hash]; block != nil && block.NumberU64() == number {
+		return block.Header()
+	}
+	return nil
+}
+
+// GetBlocks returns a block and up to count-1 of its ancestors, newest first.
+func (c *Chain) GetBlocks(hash common.Hash, number uint64, count uint64) []*types.Block {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var blocks []*types.Block
+	for block := c.blocks[hash]; block != nil && block.NumberU64() == number && uint64(len(blocks)) < count; block = c.blocks[hash] {
+		blocks = append(blocks, block)
+		if number == 0 {
+			break
+		}
+		hash, number = block.ParentHash(), number-1
+	}
+	return blocks
+}
+
+// GetBalance returns the balance of the account.
+func (c *Chain) GetBalance(addr common.Address) *big.Int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.statedb.GetBalance(addr)
+}
+
+// NonceAt returns the nonce of the account.
+func (c *Chain) NonceAt(addr common.Address) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.statedb.GetNonce(addr)
+}
+
+// NonceAtHeight returns the nonce of the account, the state is the same at
+// every height.
+func (c *Chain) NonceAtHeight(addr common.Address, height *big.Int) uint64 {
+	return c.NonceAt(addr)
+}
+
+// ChainInfo returns the chain info set by SetChainInfo, nil by default.
+func (c *Chain) ChainInfo() *chainclient.ChainInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.info
+}
+
+// SubscribeChainHeaderEvent subscribes to the heads set by SetHead.
+func (c *Chain) SubscribeChainHeaderEvent(ch chan<- chainclient.ChainHeaderEvent) event.Subscription {
+	return c.headScope.Track(c.headFeed.Subscribe(ch))
+}
@@ -0,0 +1,141 @@
+package memchain
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/trusted-defi/trusted-engine/core/chainclient"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestChainHead(t *testing.T) {
+	chain := New(params.TestChainConfig, 1000000, nil)
+	genesis := chain.CurrentBlock()
+	if genesis.NumberU64() != 0 || genesis.GasLimit() != 1000000 || genesis.BaseFee() == nil {
+		t.Fatalf("genesis mismatch: number %d, gas limit %d, base fee %v", genesis.NumberU64(), genesis.GasLimit(), genesis.BaseFee())
+	}
+	heads := make(chan chainclient.ChainHeaderEvent, 4)
+	sub := chain.SubscribeChainHeaderEvent(heads)
+	defer sub.Unsubscribe()
+
+	tx := types.NewTx(&types.LegacyTx{Nonce: 1})
+	block := chain.Extend(tx)
+	if block.ParentHash() != genesis.Hash() || block.NumberU64() != 1 || len(block.Transactions()) != 1 {
+		t.Fatalf("extended block mismatch: parent %x, number %d, %d txs", block.ParentHash(), block.NumberU64(), len(block.Transactions()))
+	}
+	if head, _ := chain.CurrentHeader(); head.Hash() != block.Hash() {
+		t.Errorf("head mismatch: have %x, want %x", head.Hash(), block.Hash())
+	}
+	select {
+	case ev := <-heads:
+		if ev.Header.Hash() != block.Hash() {
+			t.Errorf("head event mismatch: have %x, want %x", ev.Header.Hash(), block.Hash())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no head event")
+	}
+	if chain.Genesis().Hash() != genesis.Hash() {
+		t.Errorf("genesis lost after extending the chain")
+	}
+
+	// A new gas limit replaces the head without announcing it
+	chain.SetGasLimit(2000000)
+	if head := chain.CurrentBlock(); head.GasLimit() != 2000000 || head.NumberU64() != 1 {
+		t.Errorf("gas limit not applied to the head: %d", head.GasLimit())
+	}
+	if next := chain.Extend(); next.GasLimit() != 2000000 {
+		t.Errorf("gas limit not applied to new blocks: %d", next.GasLimit())
+	}
+	select {
+	case ev := <-heads:
+		if ev.Header.GasLimit != 2000000 || ev.Header.Number.Uint64() != 2 {
+			t.Errorf("unexpected head event %v", ev.Header.Number)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no head event")
+	}
+}
+
+func TestChainReorg(t *testing.T) {
+	chain := New(params.TestChainConfig, 1000000, nil)
+	var old []*types.Block
+	for i := 0; i < 3; i++ {
+		old = append(old, chain.Extend())
+	}
+	heads := make(chan chainclient.ChainHeaderEvent, 4)
+	sub := chain.SubscribeChainHeaderEvent(heads)
+	defer sub.Unsubscribe()
+
+	// Two blocks are replaced by a longer branch of empty siblings
+	head := chain.Reorg(2, nil, nil, nil)
+	if head.NumberU64() != 4 {
+		t.Fatalf("new head number mismatch: have %d, want 4", head.NumberU64())
+	}
+	if len(heads) != 1 {
+		t.Errorf("head event count mismatch: have %d, want 1", len(heads))
+	}
+	branch := chain.GetBlocks(head.Hash(), head.NumberU64(), 10)
+	if len(branch) != 5 || branch[4].NumberU64() != 0 {
+		t.Fatalf("branch length mismatch: have %d, want 5", len(branch))
+	}
+	if branch[3].Hash() != old[0].Hash() {
+		t.Errorf("common ancestor mismatch: have %x, want %x", branch[3].Hash(), old[0].Hash())
+	}
+	if branch[2].Hash() == old[1].Hash() {
+		t.Error("sibling block not unique")
+	}
+	// The old branch is still known, unknown numbers are not
+	if header := chain.GetHeader(old[2].Hash(), 3); header == nil || header.Hash() != old[2].Hash() {
+		t.Errorf("old head lost after the reorg: %v", header)
+	}
+	if header := chain.GetHeader(old[2].Hash(), 2); header != nil {
+		t.Errorf("header returned with the wrong number: %v", header)
+	}
+	if blocks := chain.GetBlocks(head.Hash(), head.NumberU64(), 2); len(blocks) != 2 {
+		t.Errorf("limited range length mismatch: have %d, want 2", len(blocks))
+	}
+
+	// Reorgs deeper than the chain stop at genesis
+	if head := chain.Reorg(100, types.Transactions{types.NewTx(&types.LegacyTx{})}); head.NumberU64() != 1 || head.ParentHash() != chain.Genesis().Hash() {
+		t.Errorf("reorg past genesis mismatch: number %d, parent %x", head.NumberU64(), head.ParentHash())
+	}
+}
+
+func TestChainState(t *testing.T) {
+	chain := New(params.TestChainConfig, 1000000, nil)
+	addr := common.HexToAddress("0x01")
+
+	chain.AddBalance(addr, big.NewInt(10))
+	chain.AddBalance(addr, big.NewInt(5))
+	if balance := chain.GetBalance(addr); balance.Cmp(big.NewInt(15)) != 0 {
+		t.Errorf("balance mismatch: have %v, want 15", balance)
+	}
+	chain.SetBalance(addr, big.NewInt(3))
+	if balance := chain.GetBalance(addr); balance.Cmp(big.NewInt(3)) != 0 {
+		t.Errorf("balance mismatch: have %v, want 3", balance)
+	}
+	chain.SetNonce(addr, 7)
+	chain.Extend()
+	// The state is served at every height and survives head changes
+	chain.Reorg(1)
+	if nonce := chain.NonceAt(addr); nonce != 7 {
+		t.Errorf("nonce mismatch: have %d, want 7", nonce)
+	}
+	if nonce := chain.NonceAtHeight(addr, common.Big0); nonce != 7 {
+		t.Errorf("nonce at genesis mismatch: have %d, want 7", nonce)
+	}
+	if chain.State().GetNonce(addr) != 7 {
+		t.Error("state database not shared")
+	}
+
+	if chain.ChainInfo() != nil {
+		t.Error("chain info set by default")
+	}
+	info := &chainclient.ChainInfo{ChainID: big.NewInt(1)}
+	chain.SetChainInfo(info)
+	if chain.ChainInfo() != info {
+		t.Error("chain info not set")
+	}
+}
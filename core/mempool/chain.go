@@ -0,0 +1,37 @@
+package mempool
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/trusted-defi/trusted-engine/core/chainclient"
+	"math/big"
+)
+
+// ChainReader provides the chain head, the ancestors needed to handle reorgs
+// and the account state to the pool. It is satisfied by chainclient.ChainClient.
+type ChainReader interface {
+	// CurrentHeader returns the header of the current head block.
+	CurrentHeader() (*types.Header, error)
+
+	// GetHeader returns the header by hash and number, nil if unknown.
+	GetHeader(hash common.Hash, number uint64) *types.Header
+
+	// GetBlocks returns a block and up to count-1 of its ancestors, newest first.
+	GetBlocks(hash common.Hash, number uint64, count uint64) []*types.Block
+
+	// GetBalance returns the balance of the account at the current head.
+	GetBalance(addr common.Address) *big.Int
+
+	// NonceAt returns the nonce of the account at the current head.
+	NonceAt(addr common.Address) uint64
+
+	// NonceAtHeight returns the nonce of the account at the given height.
+	NonceAtHeight(addr common.Address, height *big.Int) uint64
+
+	// ChainInfo returns the chain description, nil if unknown.
+	ChainInfo() *chainclient.ChainInfo
+
+	// SubscribeChainHeaderEvent subscribes to new head headers.
+	SubscribeChainHeaderEvent(ch chan<- chainclient.ChainHeaderEvent) event.Subscription
+}
@@ -0,0 +1,390 @@
+package mempool
+
+import (
+	"errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/trusted-defi/trusted-engine/core/chainclient"
+	"github.com/trusted-defi/trusted-engine/core/memchain"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Tests that transactions of blocks dropped by a reorg are put back into the
+// pool when the new head arrives from the chain.
+func TestTransactionReorgReinject(t *testing.T) {
+	t.Parallel()
+
+	chain := memchain.New(params.TestChainConfig, 10000000, nil)
+	pool := newTestTxPool(testTxPoolConfig, params.TestChainConfig, chain)
+	defer pool.Stop()
+	<-pool.initDoneCh
+
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	chain.AddBalance(from, big.NewInt(1000000000))
+
+	events := make(chan core.NewTxsEvent, 4)
+	sub := pool.SubscribeNewTxsEvent(events)
+	defer sub.Unsubscribe()
+
+	// Include the transaction in a block, then reorg it away
+	tx := transaction(0, 100000, key)
+	chain.SetNonce(from, 1)
+	chain.Extend(tx)
+
+	chain.SetNonce(from, 0)
+	chain.Reorg(1, nil, nil)
+
+	select {
+	case ev := <-events:
+		if len(ev.Txs) != 1 || ev.Txs[0].Hash() != tx.Hash() {
+			t.Fatalf("reinjected transactions mismatch: have %v, want %x", ev.Txs, tx.Hash())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("reorged transaction not reinjected")
+	}
+	if pending, _ := pool.Stats(); pending != 1 {
+		t.Fatalf("pending transactions mismatched: have %d, want %d", pending, 1)
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
+// Tests that a head jump deeper than the reorg limit resyncs the pool from the
+// new head and reports it.
+func TestTransactionDeepReorgResync(t *testing.T) {
+	t.Parallel()
+
+	config := testTxPoolConfig
+	config.MaxReorgDepth = 2
+
+	chain := memchain.New(params.TestChainConfig, 10000000, nil)
+	pool := newTestTxPool(config, params.TestChainConfig, chain)
+	defer pool.Stop()
+	<-pool.initDoneCh
+
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	chain.AddBalance(from, big.NewInt(1000000000))
+	pool.AddRemotesSync([]*types.Transaction{transaction(0, 100000, key), transaction(1, 100000, key), transaction(3, 100000, key)})
+
+	// The new branch leaves a second account without funds
+	poor, _ := crypto.GenerateKey()
+	chain.AddBalance(crypto.PubkeyToAddress(poor.PublicKey), big.NewInt(1000000000))
+	pool.AddRemotesSync([]*types.Transaction{transaction(0, 100000, poor), transaction(1, 100000, poor)})
+
+	resyncs := make(chan ResyncEvent, 1)
+	sub := pool.SubscribeResyncEvent(resyncs)
+	defer sub.Unsubscribe()
+	lifecycle := make(chan TxLifecycleEvent, 16)
+	lifecycleSub := pool.SubscribeTxLifecycleEvent(lifecycle)
+	defer lifecycleSub.Unsubscribe()
+
+	// The first transaction got included somewhere on the new branch
+	chain.SetNonce(from, 1)
+	chain.SetBalance(crypto.PubkeyToAddress(poor.PublicKey), new(big.Int))
+	head := chain.Reorg(0, nil, nil, nil)
+
+	select {
+	case ev := <-resyncs:
+		if ev.Head.Hash() != head.Hash() || ev.Depth != 3 {
+			t.Errorf("resync mismatch: have head %x depth %d, want %x depth 3", ev.Head.Hash(), ev.Depth, head.Hash())
+		}
+		if ev.Pending != 1 || ev.Queued != 1 || ev.Dropped != 3 || ev.Accounts != 1 {
+			t.Errorf("resync counts mismatch: have %+v", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no resync event")
+	}
+	// The count matches the drops reported with the resync
+	select {
+	case ev := <-lifecycle:
+		dropped := 0
+		for _, e := range ev.Events {
+			if e.Kind == TxDropped {
+				dropped++
+			}
+		}
+		if dropped != 3 {
+			t.Errorf("dropped events mismatch: have %d, want 3", dropped)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no lifecycle event")
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
+// headlessChain hides the head of the chain until it is started, like a chain
+// server which is not reachable yet.
+type headlessChain struct {
+	*memchain.Chain
+	started int32
+}
+
+func (c *headlessChain) CurrentHeader() (*types.Header, error) {
+	if atomic.LoadInt32(&c.started) == 0 {
+		return nil, errors.New("not connected")
+	}
+	return c.Chain.CurrentHeader()
+}
+
+// Tests that a pool started without a chain head reports why it isn't ready and
+// loads its journal once the first head arrives.
+func TestTransactionReadiness(t *testing.T) {
+	t.Parallel()
+
+	journal := filepath.Join(t.TempDir(), "transactions.rlp")
+	config := testTxPoolConfig
+	config.Journal = journal
+
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	tx := transaction(0, 100000, key)
+
+	// Journal a local transaction with a pool which knows the head
+	chain := memchain.New(params.TestChainConfig, 10000000, nil)
+	chain.AddBalance(from, big.NewInt(1000000000))
+	pool := newTestTxPool(config, params.TestChainConfig, chain)
+	if err := pool.AddLocal(tx); err != nil {
+		t.Fatalf("failed to add local transaction: %v", err)
+	}
+	if reasons := pool.Readiness(); len(reasons) != 0 {
+		t.Fatalf("pool with head not ready: %v", reasons)
+	}
+	pool.Stop()
+
+	// Restart without a head, the journal must stay untouched until one arrives
+	headless := &headlessChain{Chain: chain}
+	pool = newTestTxPool(config, params.TestChainConfig, headless)
+	defer pool.Stop()
+	<-pool.initDoneCh
+
+	if reasons := pool.Readiness(); len(reasons) != 2 {
+		t.Fatalf("readiness reasons mismatch: have %v, want 2", reasons)
+	}
+	atomic.StoreInt32(&headless.started, 1)
+	chain.Extend()
+
+	for deadline := time.Now().Add(time.Second); !pool.IsReady(); time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("pool not ready after first head: %v", pool.Readiness())
+		}
+	}
+	if pool.Get(tx.Hash()) == nil || !pool.locals.contains(from) {
+		t.Fatal("journaled local transaction not loaded")
+	}
+}
+
+// Tests that a pool started before chain server was reached checks the chain
+// config with the first head, and ignores the heads of a mismatching chain.
+func TestTransactionDeferredChainCheck(t *testing.T) {
+	t.Parallel()
+
+	chain := memchain.New(params.TestChainConfig, 10000000, nil)
+	headless := &headlessChain{Chain: chain}
+	pool := newTestTxPool(testTxPoolConfig, params.TestChainConfig, headless)
+	defer pool.Stop()
+	<-pool.initDoneCh
+
+	// Chain server turns out to follow another chain
+	chain.SetChainInfo(&chainclient.ChainInfo{ChainID: big.NewInt(2), Features: map[string]bool{}})
+	atomic.StoreInt32(&headless.started, 1)
+	chain.Extend()
+
+	for deadline := time.Now().Add(time.Second); ; time.Sleep(10 * time.Millisecond) {
+		reasons := pool.Readiness()
+		if len(reasons) > 0 && strings.Contains(reasons[0], chainclient.ErrChainIDMismatch.Error()) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("mismatching chain not reported: %v", reasons)
+		}
+	}
+	if atomic.LoadInt32(&pool.headReady) == 1 {
+		t.Error("pool reset to a head of the mismatching chain")
+	}
+}
+
+// Tests that transactions included by a new head are reported as included in
+// their block, follow reorgs, and that dropped ones keep their reason.
+func TestTransactionIncludedStatus(t *testing.T) {
+	t.Parallel()
+
+	chain := memchain.New(params.TestChainConfig, 10000000, nil)
+	pool := newTestTxPool(testTxPoolConfig, params.TestChainConfig, chain)
+	defer pool.Stop()
+	<-pool.initDoneCh
+
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	chain.AddBalance(from, big.NewInt(1000000000))
+
+	tx0, tx1 := transaction(0, 100000, key), transaction(1, 100000, key)
+	pool.AddRemotesSync([]*types.Transaction{tx0, tx1})
+
+	events := make(chan TxLifecycleEvent, 16)
+	sub := pool.SubscribeTxLifecycleEvent(events)
+	defer sub.Unsubscribe()
+
+	waitEvent := func(kind TxEventKind, block *types.Block) {
+		t.Helper()
+		for {
+			select {
+			case ev := <-events:
+				for _, e := range ev.Events {
+					if e.Kind == kind && e.Tx.Hash() == tx0.Hash() {
+						if e.BlockHash != block.Hash() || e.BlockNumber != block.NumberU64() {
+							t.Errorf("%v event block mismatch: have %x/%d, want %x/%d", kind, e.BlockHash, e.BlockNumber, block.Hash(), block.NumberU64())
+						}
+						return
+					}
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("no %v event", kind)
+			}
+		}
+	}
+	checkRecord := func(block *types.Block) {
+		t.Helper()
+		if status := pool.Status([]common.Hash{tx0.Hash(), tx1.Hash(), {}}); status[0] != TxStatusIncluded || status[1] != TxStatusPending || status[2] != TxStatusUnknown {
+			t.Errorf("status mismatch: have %v, want included/pending/unknown", status)
+		}
+		if rec := pool.Record(tx0.Hash()); rec == nil || rec.BlockHash != block.Hash() || rec.BlockNumber != block.NumberU64() {
+			t.Errorf("record mismatch: have %+v, want block %x/%d", rec, block.Hash(), block.NumberU64())
+		}
+	}
+	chain.SetNonce(from, 1)
+	block := chain.Extend(tx0)
+	waitEvent(TxIncluded, block)
+	checkRecord(block)
+
+	// A reorg including it again moves the record to the new block
+	head := chain.Reorg(1, types.Transactions{tx0}, nil)
+	moved := types.NewBlockWithHeader(chain.GetHeader(head.ParentHash(), head.NumberU64()-1))
+	waitEvent(TxIncluded, moved)
+	checkRecord(moved)
+
+	// A reorg dropping it reinjects it and forgets the record
+	chain.SetNonce(from, 0)
+	chain.Reorg(2, nil, nil, nil)
+	waitEvent(TxReorged, moved)
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if pool.Status([]common.Hash{tx0.Hash()})[0] == TxStatusPending {
+			break
+		}
+	}
+	if status := pool.Status([]common.Hash{tx0.Hash()}); status[0] != TxStatusPending || pool.Record(tx0.Hash()) != nil {
+		t.Errorf("reinjected status mismatch: have %v, record %+v", status, pool.Record(tx0.Hash()))
+	}
+
+	// Dropped transactions keep their reason
+	pool.SetGasPrice(big.NewInt(2))
+	if rec := pool.Record(tx1.Hash()); rec == nil || rec.Status != TxStatusDropped || rec.Reason != DropBelowPrice {
+		t.Errorf("dropped record mismatch: have %+v", rec)
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
+// bodylessChain withholds block bodies once missing is set, like a chain
+// server failing to serve them.
+type bodylessChain struct {
+	*memchain.Chain
+	missing int32
+}
+
+func (c *bodylessChain) GetBlocks(hash common.Hash, number uint64, count uint64) []*types.Block {
+	if atomic.LoadInt32(&c.missing) == 1 {
+		return nil
+	}
+	return c.Chain.GetBlocks(hash, number, count)
+}
+
+// Tests that transactions leaving the pool with a new head whose block can't be
+// fetched are not recorded as dropped.
+func TestTransactionHeadBlockMissing(t *testing.T) {
+	t.Parallel()
+
+	chain := &bodylessChain{Chain: memchain.New(params.TestChainConfig, 10000000, nil)}
+	pool := newTestTxPool(testTxPoolConfig, params.TestChainConfig, chain)
+	defer pool.Stop()
+	<-pool.initDoneCh
+
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	chain.AddBalance(from, big.NewInt(1000000000))
+
+	tx := transaction(0, 100000, key)
+	if err := pool.addRemoteSync(tx); err != nil {
+		t.Fatalf("failed to add transaction: %v", err)
+	}
+	events := make(chan TxLifecycleEvent, 16)
+	sub := pool.SubscribeTxLifecycleEvent(events)
+	defer sub.Unsubscribe()
+
+	atomic.StoreInt32(&chain.missing, 1)
+	chain.SetNonce(from, 1)
+	chain.Extend(tx)
+
+	for deadline := time.Now().Add(2 * time.Second); pool.Has(tx.Hash()); time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("mined transaction not removed")
+		}
+	}
+	// The reset sends its events once done, wait for it
+	<-pool.requestPromoteExecutables(newAccountSet(pool.signer))
+
+	if status := pool.Status([]common.Hash{tx.Hash()}); status[0] != TxStatusUnknown {
+		t.Errorf("status mismatch: have %v, want unknown", status[0])
+	}
+	if rec := pool.Record(tx.Hash()); rec != nil {
+		t.Errorf("transaction recorded without its block: %+v", rec)
+	}
+	for {
+		select {
+		case ev := <-events:
+			for _, e := range ev.Events {
+				if e.Kind == TxDropped {
+					t.Errorf("dropped event for transaction %x", e.Tx.Hash())
+				}
+			}
+			continue
+		default:
+		}
+		break
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
+// Tests that the history is bounded by slots and lifetime, and that replaced
+// records aren't evicted by their earlier entries.
+func TestTxHistoryLimits(t *testing.T) {
+	h := newTxHistory()
+	hashes := []common.Hash{{1}, {2}, {3}}
+	old := time.Now().Add(-time.Hour)
+	for _, hash := range hashes {
+		h.add(hash, &TxRecord{Status: TxStatusDropped, Time: old}, 2)
+	}
+	if h.len() != 2 || h.get(hashes[0]) != nil {
+		t.Fatalf("slot limit not applied: %d records", h.len())
+	}
+	h.add(hashes[1], &TxRecord{Status: TxStatusIncluded, Time: time.Now()}, 2)
+	h.expire(time.Minute)
+	if h.len() != 1 || h.get(hashes[1]) == nil || h.get(hashes[1]).Status != TxStatusIncluded {
+		t.Fatalf("lifetime not applied: %d records", h.len())
+	}
+}
@@ -0,0 +1,105 @@
+package mempool
+
+import (
+	"bytes"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+	"sort"
+)
+
+// ContentQuery selects the pool transactions of a paged or streamed content
+// query. Transactions are ordered by sender and nonce.
+type ContentQuery struct {
+	Pending bool     // Include pending transactions
+	Queued  bool     // Include queued transactions
+	Locals  bool     // Include transactions of local senders
+	Remotes bool     // Include transactions of remote senders
+	MinTip  *big.Int // Minimum effective tip at the current base fee, nil for any
+
+	After *ContentCursor // Position to continue after, nil to start with the first sender
+	Limit int            // Maximum number of transactions, 0 for all
+}
+
+// ContentCursor is the position of a transaction in content order.
+type ContentCursor struct {
+	Sender common.Address
+	Nonce  uint64
+}
+
+// ContentTx is a transaction selected by a content query.
+type ContentTx struct {
+	Sender  common.Address
+	Tx      *types.Transaction
+	Pending bool
+	Local   bool
+}
+
+// Cursor returns the position of the transaction, a query after it continues
+// with the next one.
+func (tx *ContentTx) Cursor() *ContentCursor {
+	return &ContentCursor{Sender: tx.Sender, Nonce: tx.Tx.Nonce()}
+}
+
+// QueryContent returns the transactions selected by the query, and whether
+// more follow after the limit. They are taken from one snapshot of the pool,
+// the read lock is only held while collecting them.
+func (pool *TxPool) QueryContent(q ContentQuery) ([]*ContentTx, bool) {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	senders := make([]common.Address, 0, len(pool.pending)+len(pool.queue))
+	for addr := range pool.pending {
+		senders = append(senders, addr)
+	}
+	for addr := range pool.queue {
+		if _, ok := pool.pending[addr]; !ok {
+			senders = append(senders, addr)
+		}
+	}
+	sort.Slice(senders, func(i, j int) bool {
+		return bytes.Compare(senders[i][:], senders[j][:]) < 0
+	})
+	start := 0
+	if q.After != nil {
+		start = sort.Search(len(senders), func(i int) bool {
+			return bytes.Compare(senders[i][:], q.After.Sender[:]) >= 0
+		})
+	}
+	var (
+		baseFee = pool.priced.urgent.baseFee
+		txs     []*ContentTx
+	)
+	for _, addr := range senders[start:] {
+		local := pool.locals.contains(addr)
+		if (local && !q.Locals) || (!local && !q.Remotes) {
+			continue
+		}
+		// Queued nonces of a sender are all above the pending ones
+		for _, part := range []struct {
+			list    *txList
+			pending bool
+			want    bool
+		}{
+			{pool.pending[addr], true, q.Pending},
+			{pool.queue[addr], false, q.Queued},
+		} {
+			if part.list == nil || !part.want {
+				continue
+			}
+			for _, tx := range part.list.txs.sorted() {
+				if q.After != nil && addr == q.After.Sender && tx.Nonce() <= q.After.Nonce {
+					continue
+				}
+				if q.MinTip != nil && tx.EffectiveGasTipIntCmp(q.MinTip, baseFee) < 0 {
+					continue
+				}
+				if q.Limit > 0 && len(txs) == q.Limit {
+					return txs, true
+				}
+				txs = append(txs, &ContentTx{Sender: addr, Tx: tx, Pending: part.pending, Local: local})
+			}
+		}
+	}
+	return txs, false
+}
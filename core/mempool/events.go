@@ -0,0 +1,119 @@
+package mempool
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ResyncEvent is posted when a reorg deeper than the configured limit made the
+// pool rebuild its pending and queued transactions against the new head.
+type ResyncEvent struct {
+	Head     *types.Header // Head the pool was resynced to
+	Depth    uint64        // Depth of the reorg which triggered the resync
+	Accounts int           // Number of accounts left with transactions in the pool
+	Pending  int           // Number of executable transactions after the resync
+	Queued   int           // Number of non-executable transactions after the resync
+	Dropped  int           // Number of transactions dropped from the resync until its promotion is done
+}
+
+// TxEventKind is the kind of a transaction lifecycle event.
+type TxEventKind uint8
+
+const (
+	TxQueued     TxEventKind = iota + 1 // Added to the non-executable queue
+	TxPromoted                          // Added to the pending set, from the queue or replacing a pending transaction
+	TxDemoted                           // Moved from the pending set back to the queue
+	TxReplaced                          // Replaced by a transaction with the same nonce
+	TxDropped                           // Removed from the pool without being included
+	TxReinjected                        // Added again after a reorg dropped its block
+	TxIncluded                          // Included by a new block, or moved to another block by a reorg
+	TxReorged                           // Its including block was reorged out
+)
+
+func (k TxEventKind) String() string {
+	switch k {
+	case TxQueued:
+		return "queued"
+	case TxPromoted:
+		return "promoted"
+	case TxDemoted:
+		return "demoted"
+	case TxReplaced:
+		return "replaced"
+	case TxDropped:
+		return "dropped"
+	case TxReinjected:
+		return "reinjected"
+	case TxIncluded:
+		return "included"
+	case TxReorged:
+		return "reorged"
+	}
+	return "unknown"
+}
+
+// TxDropReason tells why a transaction was dropped.
+type TxDropReason uint8
+
+const (
+	DropNone         TxDropReason = iota
+	DropUnderpriced               // Evicted for a better priced transaction in a full pool
+	DropBelowPrice                // Below a raised minimum gas price
+	DropLifetime                  // Queued longer than the configured lifetime
+	DropNonceTooLow               // Nonce used on chain, usually by this transaction
+	DropUnpayable                 // Balance too low or gas above the block limit
+	DropAccountLimit              // Above the queued transactions of its account
+	DropPendingLimit              // Above the global pending slots
+	DropQueueLimit                // Above the global queue slots
+	DropOutbid                    // A pending transaction with the same nonce pays more
+	DropResync                    // Not requeued by a resync after a deep reorg
+	DropReplaced                  // Replaced by a transaction with the same nonce
+)
+
+func (r TxDropReason) String() string {
+	switch r {
+	case DropNone:
+		return "none"
+	case DropUnderpriced:
+		return "underpriced"
+	case DropBelowPrice:
+		return "below price"
+	case DropLifetime:
+		return "lifetime"
+	case DropNonceTooLow:
+		return "nonce too low"
+	case DropUnpayable:
+		return "unpayable"
+	case DropAccountLimit:
+		return "account limit"
+	case DropPendingLimit:
+		return "pending limit"
+	case DropQueueLimit:
+		return "queue limit"
+	case DropOutbid:
+		return "outbid"
+	case DropResync:
+		return "resync"
+	case DropReplaced:
+		return "replaced"
+	}
+	return "unknown"
+}
+
+// TxEvent is a change of a transaction in the pool.
+type TxEvent struct {
+	Kind       TxEventKind
+	Tx         *types.Transaction
+	Sender     common.Address
+	ReplacedBy *types.Transaction // Replacing transaction of TxReplaced
+	Reason     TxDropReason       // Reason of TxDropped
+
+	BlockHash   common.Hash // Including block of TxIncluded
+	BlockNumber uint64      // Number of the including block of TxIncluded
+}
+
+// TxLifecycleEvent is posted with the transaction changes of a pool update, in
+// the order they happened.
+type TxLifecycleEvent struct {
+	Events []TxEvent
+}
@@ -0,0 +1,79 @@
+package mempool
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TxRecord is what the pool remembers of a transaction that left it.
+type TxRecord struct {
+	Status      TxStatus       // TxStatusIncluded or TxStatusDropped
+	BlockHash   common.Hash    // Block including the transaction, if included
+	BlockNumber uint64         // Number of the including block, if included
+	Reason      TxDropReason   // Reason the transaction was dropped, if dropped
+	Sender      common.Address // Sender of the transaction, if dropped
+	Time        time.Time      // When the transaction was included or dropped
+}
+
+// txHistoryEntry is the position of a record in insertion order.
+type txHistoryEntry struct {
+	hash common.Hash
+	time time.Time
+}
+
+// txHistory is a bounded, time-windowed index of the transactions that left
+// the pool, answering status queries after they are gone. Records are evicted
+// in insertion order once above the slot limit or older than the lifetime.
+type txHistory struct {
+	records map[common.Hash]*TxRecord
+	order   []txHistoryEntry
+}
+
+func newTxHistory() *txHistory {
+	return &txHistory{records: make(map[common.Hash]*TxRecord)}
+}
+
+// add records a transaction, replacing an earlier record of it, and evicts the
+// oldest records above the limit.
+func (h *txHistory) add(hash common.Hash, rec *TxRecord, limit uint64) {
+	h.records[hash] = rec
+	h.order = append(h.order, txHistoryEntry{hash: hash, time: rec.Time})
+	for uint64(len(h.records)) > limit {
+		h.pop()
+	}
+}
+
+// get returns the record of a transaction, nil if there is none.
+func (h *txHistory) get(hash common.Hash) *TxRecord {
+	return h.records[hash]
+}
+
+// remove forgets a transaction.
+func (h *txHistory) remove(hash common.Hash) {
+	delete(h.records, hash)
+}
+
+// expire evicts the records older than the lifetime.
+func (h *txHistory) expire(lifetime time.Duration) {
+	for len(h.order) > 0 && time.Since(h.order[0].time) > lifetime {
+		h.pop()
+	}
+	if len(h.order) == 0 {
+		h.order = nil
+	}
+}
+
+// pop evicts the oldest entry, its record only if not replaced since.
+func (h *txHistory) pop() {
+	entry := h.order[0]
+	h.order = h.order[1:]
+	if rec := h.records[entry.hash]; rec != nil && rec.Time.Equal(entry.time) {
+		delete(h.records, entry.hash)
+	}
+}
+
+// len returns the number of remembered transactions.
+func (h *txHistory) len() int {
+	return len(h.records)
+}
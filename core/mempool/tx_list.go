@@ -209,6 +209,17 @@ func (m *txSortedMap) flatten() types.Transactions {
 	return m.cache
 }
 
+// sorted creates a nonce-sorted slice of transactions without touching the
+// cache, so it may be used with only the read lock of the pool held.
+func (m *txSortedMap) sorted() types.Transactions {
+	txs := make(types.Transactions, 0, len(m.items))
+	for _, tx := range m.items {
+		txs = append(txs, tx)
+	}
+	sort.Sort(types.TxByNonce(txs))
+	return txs
+}
+
 // Flatten creates a nonce-sorted slice of transactions based on the loosely
 // sorted internal representation. The result of the sorting is cached in case
 // it's requested again before any modifications are made to the contents.
@@ -1,7 +1,6 @@
 package mempool
 
 import (
-	chainclient2 "github.com/trusted-defi/trusted-engine/core/chainclient"
 	"math/big"
 	"sync"
 
@@ -12,18 +11,18 @@ import (
 // accounts in the pool, falling back to reading from a real state database if
 // an account is unknown.
 type txNoncer struct {
-	height      *big.Int
-	nonces      map[common.Address]uint64
-	chainclient *chainclient2.ChainClient
-	lock        sync.Mutex
+	height *big.Int
+	nonces map[common.Address]uint64
+	chain  ChainReader
+	lock   sync.Mutex
 }
 
 // newTxNoncer creates a new virtual state database to track the pool nonces.
-func newTxNoncer(height *big.Int, chain *chainclient2.ChainClient) *txNoncer {
+func newTxNoncer(height *big.Int, chain ChainReader) *txNoncer {
 	return &txNoncer{
-		height:      height,
-		chainclient: chain,
-		nonces:      make(map[common.Address]uint64),
+		height: height,
+		chain:  chain,
+		nonces: make(map[common.Address]uint64),
 	}
 }
 
@@ -36,7 +35,7 @@ func (txn *txNoncer) get(addr common.Address) uint64 {
 	defer txn.lock.Unlock()
 
 	if _, ok := txn.nonces[addr]; !ok {
-		txn.nonces[addr] = txn.chainclient.NonceAtHeight(addr, txn.height)
+		txn.nonces[addr] = txn.chain.NonceAtHeight(addr, txn.height)
 	}
 	return txn.nonces[addr]
 }
@@ -57,7 +56,7 @@ func (txn *txNoncer) setIfLower(addr common.Address, nonce uint64) {
 	defer txn.lock.Unlock()
 
 	if _, ok := txn.nonces[addr]; !ok {
-		txn.nonces[addr] = txn.chainclient.NonceAtHeight(addr, txn.height)
+		txn.nonces[addr] = txn.chain.NonceAtHeight(addr, txn.height)
 	}
 	if txn.nonces[addr] <= nonce {
 		return
@@ -3,14 +3,17 @@ package mempool
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/sirupsen/logrus"
-	"github.com/trusted-defi/trusted-engine/config"
 	"github.com/trusted-defi/trusted-engine/core/chainclient"
+	"io/fs"
 	"math"
 	"math/big"
-	"path/filepath"
+	"os"
+	"reflect"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -26,7 +29,7 @@ import (
 var log = logrus.WithField("prefix", "pool")
 
 const (
-	// chainHeadChanSize is the size of channel listening to ChainHeadEvent.
+	// chainHeadChanSize is the size of channel listening to ChainHeaderEvent.
 	chainHeadChanSize = 10
 
 	// txSlotSize is used to calculate how many data slots a single transaction
@@ -40,6 +43,13 @@ const (
 	// more expensive to propagate; larger transactions also take more resources
 	// to validate whether they fit into the pool or not.
 	txMaxSize = 4 * txSlotSize // 128KB
+
+	// headBlockRetries is how often the block of a new head is fetched before
+	// the pool gives up recording which transactions it included.
+	headBlockRetries = 3
+
+	// headBlockRetryDelay is the wait between fetches of the block of a new head.
+	headBlockRetryDelay = 100 * time.Millisecond
 )
 
 var (
@@ -74,6 +84,10 @@ var (
 	// than some meaningful limit a user might use. This is not a consensus error
 	// making the transaction invalid, rather a DOS protection.
 	ErrOversizedData = errors.New("oversized data")
+
+	// errNoChainConfig is returned if no chain config is configured and chain
+	// server doesn't announce one.
+	errNoChainConfig = errors.New("no chain config, set network or genesis file")
 )
 
 var (
@@ -89,6 +103,7 @@ const (
 	TxStatusQueued
 	TxStatusPending
 	TxStatusIncluded
+	TxStatusDropped
 )
 
 // TxPoolConfig are the configuration parameters of the transaction pool.
@@ -96,6 +111,7 @@ type TxPoolConfig struct {
 	NoLocals  bool          // Whether local transaction handling should be disabled
 	Journal   string        // Journal of local transactions to survive node restarts
 	Rejournal time.Duration // Time interval to regenerate the local transaction journal
+	Snapshot  string        // Snapshot of remote transactions written on stop and loaded on start
 
 	PriceLimit uint64 // Minimum gas price to enforce for acceptance into the pool
 	PriceBump  uint64 // Minimum price bump percentage to replace an already existing transaction (nonce)
@@ -106,6 +122,11 @@ type TxPoolConfig struct {
 	GlobalQueue  uint64 // Maximum number of non-executable transaction slots for all accounts
 
 	Lifetime time.Duration // Maximum amount of time non-executable transaction are queued
+
+	MaxReorgDepth uint64 // Maximum reorg depth to reinject transactions for, deeper ones trigger a full resync
+
+	HistorySlots    uint64        // Number of included or dropped transactions remembered for status queries
+	HistoryLifetime time.Duration // Maximum amount of time included or dropped transactions are remembered
 }
 
 // DefaultTxPoolConfig contains the default configurations for the transaction
@@ -113,6 +134,7 @@ type TxPoolConfig struct {
 var DefaultTxPoolConfig = TxPoolConfig{
 	Journal:   "transactions.rlp",
 	Rejournal: time.Hour,
+	Snapshot:  "transactions_snapshot.rlp",
 
 	PriceLimit: 1,
 	PriceBump:  10,
@@ -123,11 +145,16 @@ var DefaultTxPoolConfig = TxPoolConfig{
 	GlobalQueue:  1024,
 
 	Lifetime: 3 * time.Hour,
+
+	MaxReorgDepth: 64,
+
+	HistorySlots:    65536,
+	HistoryLifetime: time.Hour,
 }
 
-// sanitize checks the provided user configurations and changes anything that's
+// Sanitize checks the provided user configurations and changes anything that's
 // unreasonable or unworkable.
-func (config *TxPoolConfig) sanitize() TxPoolConfig {
+func (config *TxPoolConfig) Sanitize() TxPoolConfig {
 	conf := *config
 	if conf.Rejournal < time.Second {
 		log.Warn("Sanitizing invalid txpool journal time", "provided", conf.Rejournal, "updated", time.Second)
@@ -161,6 +188,18 @@ func (config *TxPoolConfig) sanitize() TxPoolConfig {
 		log.Warn("Sanitizing invalid txpool lifetime", "provided", conf.Lifetime, "updated", DefaultTxPoolConfig.Lifetime)
 		conf.Lifetime = DefaultTxPoolConfig.Lifetime
 	}
+	if conf.MaxReorgDepth < 1 {
+		log.Warn("Sanitizing invalid txpool max reorg depth", "provided", conf.MaxReorgDepth, "updated", DefaultTxPoolConfig.MaxReorgDepth)
+		conf.MaxReorgDepth = DefaultTxPoolConfig.MaxReorgDepth
+	}
+	if conf.HistorySlots < 1 {
+		log.Warn("Sanitizing invalid txpool history slots", "provided", conf.HistorySlots, "updated", DefaultTxPoolConfig.HistorySlots)
+		conf.HistorySlots = DefaultTxPoolConfig.HistorySlots
+	}
+	if conf.HistoryLifetime < 1 {
+		log.Warn("Sanitizing invalid txpool history lifetime", "provided", conf.HistoryLifetime, "updated", DefaultTxPoolConfig.HistoryLifetime)
+		conf.HistoryLifetime = DefaultTxPoolConfig.HistoryLifetime
+	}
 	return conf
 }
 
@@ -175,11 +214,14 @@ type TxPool struct {
 	ctx         context.Context
 	config      TxPoolConfig
 	chainconfig *params.ChainConfig
+	chain       ChainReader
 
-	chainHeadCh  chan core.ChainHeadEvent
+	chainHeadCh  chan chainclient.ChainHeaderEvent
 	chainHeadSub event.Subscription
 	gasPrice     *big.Int
 	txFeed       event.Feed
+	resyncFeed   event.Feed
+	txEventFeed  event.Feed
 	scope        event.SubscriptionScope
 	signer       types.Signer
 	mu           sync.RWMutex
@@ -191,13 +233,15 @@ type TxPool struct {
 	pendingNonces *txNoncer // Pending state tracking virtual nonces
 	currentMaxGas uint64    // Current gas limit for transaction caps
 
-	locals  *accountSet // Set of local transaction to exempt from eviction rules
-	journal *txJournal  // Journal of local transaction to back up to disk
+	locals   *accountSet // Set of local transaction to exempt from eviction rules
+	journal  *txJournal  // Journal of local transaction to back up to disk
+	snapshot *txJournal  // Snapshot of remote transactions written on stop
 
 	pending map[common.Address]*txList   // All currently processable transactions
 	queue   map[common.Address]*txList   // Queued but non-processable transactions
 	beats   map[common.Address]time.Time // Last heartbeat from each known account
 	all     *txLookup                    // All transactions to allow lookups
+	history *txHistory                   // Transactions which left the pool, for status queries
 	priced  *txPricedList                // All transactions sorted by price
 
 	reqResetCh      chan *txpoolResetRequest
@@ -210,7 +254,16 @@ type TxPool struct {
 
 	changesSinceReorg int // A counter for how many drops we've performed in-between reorg.
 
-	chainclient *chainclient.ChainClient
+	resync        *ResyncEvent // Set by reset when a deep reorg requires a full resync
+	resyncEvents  int          // Index of the first lifecycle event of the resync
+	txEvents      []TxEvent    // Lifecycle events to send once the pool lock is released
+	headUnchecked bool         // Set by reset when the block of the new head couldn't be fetched
+
+	chainUnchecked bool         // Set if chain server wasn't reached yet to check the chain config
+	chainErr       atomic.Value // string, why the chain config doesn't match chain server
+
+	headReady    int32 // Set once the pool was reset to a chain head (atomic)
+	journalReady int32 // Set once the local transaction journal is loaded (atomic)
 }
 
 type txpoolResetRequest struct {
@@ -218,26 +271,32 @@ type txpoolResetRequest struct {
 }
 
 // NewTxPool creates a new transaction pool to gather, sort and filter inbound
-// transactions from the network.
-func NewTxPool(conf TxPoolConfig, chainconfig *params.ChainConfig, nodeconfig config.NodeConfig) *TxPool {
-
+// transactions from the network. If chainconfig is nil, the chain config
+// announced by chain server is used.
+func NewTxPool(conf TxPoolConfig, chainconfig *params.ChainConfig, chain ChainReader) (*TxPool, error) {
 	if chainconfig == nil {
-		chainconfig = params.MainnetChainConfig
+		if info := chain.ChainInfo(); info != nil && info.ChainConfig != nil {
+			chainconfig = info.ChainConfig
+		} else {
+			return nil, errNoChainConfig
+		}
 	}
 	// Sanitize the input to ensure no vulnerable gas prices are set
-	conf = (&conf).sanitize()
+	conf = (&conf).Sanitize()
 
 	// Create the transaction pool with its initial settings
 	pool := &TxPool{
 		ctx:             context.Background(),
 		config:          conf,
 		chainconfig:     chainconfig,
+		chain:           chain,
 		signer:          types.LatestSigner(chainconfig),
 		pending:         make(map[common.Address]*txList),
 		queue:           make(map[common.Address]*txList),
 		beats:           make(map[common.Address]time.Time),
-		chainHeadCh:     make(chan core.ChainHeadEvent, chainHeadChanSize),
+		chainHeadCh:     make(chan chainclient.ChainHeaderEvent, chainHeadChanSize),
 		all:             newTxLookup(),
+		history:         newTxHistory(),
 		reqResetCh:      make(chan *txpoolResetRequest),
 		reqPromoteCh:    make(chan *accountSet),
 		queueTxEventCh:  make(chan *types.Transaction),
@@ -246,27 +305,69 @@ func NewTxPool(conf TxPoolConfig, chainconfig *params.ChainConfig, nodeconfig co
 		initDoneCh:      make(chan struct{}),
 		gasPrice:        new(big.Int).SetUint64(conf.PriceLimit),
 	}
-	var err error
-	pool.chainclient, err = chainclient.NewChainClient(nodeconfig)
-	if err != nil {
-		log.Error("create chain client failed", "err", err)
-		return nil
-	}
 	pool.locals = newAccountSet(pool.signer)
 	pool.priced = newTxPricedList(pool.all)
-	if currentBlock, _ := pool.chainclient.CurrentBlock(); currentBlock != nil {
-		pool.reset(nil, currentBlock.Header())
+	currentHeader, _ := pool.chain.CurrentHeader()
+	info := pool.chain.ChainInfo()
+	if err := checkChainConfig(chainconfig, info, currentHeader); err != nil {
+		log.Error("chain server doesn't match chain config", "err", err)
+		return nil, err
+	}
+	// Without chain server the check is done with the first head
+	pool.chainUnchecked = info == nil
+	if currentHeader != nil {
+		pool.reset(nil, currentHeader, nil)
 	}
 
 	// Start the reorg loop early so it can handle requests generated during journal loading.
 	pool.wg.Add(1)
 	go pool.scheduleReorgLoop()
 
-	// If local transactions and journaling is enabled, load from disk
+	// If local transactions and journaling is enabled, load from disk. Without a
+	// chain head the nonces are unknown, so loading waits for the first one.
 	if !conf.NoLocals && conf.Journal != "" {
-		jourpath := filepath.Join(nodeconfig.NodeDir, conf.Journal)
-		pool.journal = newTxJournal(jourpath)
+		pool.journal = newTxJournal(conf.Journal)
+	}
+	if conf.Snapshot != "" {
+		pool.snapshot = newTxJournal(conf.Snapshot)
+	}
+	if currentHeader != nil {
+		pool.loadJournal()
+	}
+
+	pool.chainHeadSub = pool.chain.SubscribeChainHeaderEvent(pool.chainHeadCh)
+
+	pool.wg.Add(1)
+	go pool.loop()
+
+	return pool, nil
+}
 
+// checkChainConfig verifies the chain config of the pool agrees with the chain
+// followed by chain server, up to the current head.
+func checkChainConfig(chainconfig *params.ChainConfig, info *chainclient.ChainInfo, head *types.Header) error {
+	if info == nil || info.Legacy {
+		return nil
+	}
+	if info.ChainID.Sign() > 0 && (chainconfig.ChainID == nil || chainconfig.ChainID.Cmp(info.ChainID) != 0) {
+		return fmt.Errorf("%w: pool %v, chain server %v", chainclient.ErrChainIDMismatch, chainconfig.ChainID, info.ChainID)
+	}
+	if info.ChainConfig != nil {
+		height := uint64(0)
+		if head != nil {
+			height = head.Number.Uint64()
+		}
+		if err := chainconfig.CheckCompatible(info.ChainConfig, height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadJournal loads the local transactions from the journal and rotates it,
+// and the remote transactions from the snapshot of the last stop.
+func (pool *TxPool) loadJournal() {
+	if pool.journal != nil {
 		if err := pool.journal.load(pool.AddLocals); err != nil {
 			log.Warn("Failed to load transaction journal", "err", err)
 		}
@@ -274,17 +375,37 @@ func NewTxPool(conf TxPoolConfig, chainconfig *params.ChainConfig, nodeconfig co
 			log.Warn("Failed to rotate transaction journal", "err", err)
 		}
 	}
+	if pool.snapshot != nil {
+		if err := pool.snapshot.load(pool.AddRemotesSync); err != nil {
+			log.Warn("Failed to load transaction snapshot", "err", err)
+		}
+		// The snapshot is only good for one start, a crash must not load it again
+		if err := os.Remove(pool.snapshot.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			log.Warn("Failed to remove transaction snapshot", "err", err)
+		}
+	}
+	atomic.StoreInt32(&pool.journalReady, 1)
+}
 
-	pool.chainHeadSub = pool.chainclient.SubscribeChainHeadEvent(pool.chainHeadCh)
-
-	pool.wg.Add(1)
-	go pool.loop()
-
-	return pool
+// Readiness returns the reasons the pool is not ready to serve yet, or nil if
+// it is ready.
+func (pool *TxPool) Readiness() []string {
+	var reasons []string
+	if reason, ok := pool.chainErr.Load().(string); ok {
+		reasons = append(reasons, "chain server doesn't match chain config: "+reason)
+	}
+	if atomic.LoadInt32(&pool.headReady) == 0 {
+		reasons = append(reasons, "no chain head yet")
+	}
+	if atomic.LoadInt32(&pool.journalReady) == 0 {
+		reasons = append(reasons, "journal not loaded")
+	}
+	return reasons
 }
 
+// IsReady reports whether the pool is ready to serve.
 func (pool *TxPool) IsReady() bool {
-	return true
+	return len(pool.Readiness()) == 0
 }
 
 // loop is the transaction pool's main event loop, waiting for and reacting to
@@ -300,7 +421,7 @@ func (pool *TxPool) loop() {
 		evict   = time.NewTicker(evictionInterval)
 		journal = time.NewTicker(pool.config.Rejournal)
 		// Track the previous head headers for transaction reorgs
-		head, _ = pool.chainclient.CurrentBlock()
+		head, _ = pool.chain.CurrentHeader()
 	)
 	defer report.Stop()
 	defer evict.Stop()
@@ -310,17 +431,29 @@ func (pool *TxPool) loop() {
 	close(pool.initDoneCh)
 	for {
 		select {
-		// Handle ChainHeadEvent
+		// Handle ChainHeaderEvent
 		case ev := <-pool.chainHeadCh:
-			var last *types.Header = nil
-			if head != nil {
-				last = head.Header()
+			if ev.Header != nil {
+				if pool.chainUnchecked {
+					if err := checkChainConfig(pool.chainconfig, pool.chain.ChainInfo(), ev.Header); err != nil {
+						log.Error("chain server doesn't match chain config, ignoring its heads", "err", err)
+						pool.chainErr.Store(err.Error())
+						continue
+					}
+					pool.chainUnchecked = false
+				}
+				done := pool.requestReset(head, ev.Header)
+				head = ev.Header
+				if atomic.LoadInt32(&pool.journalReady) == 0 {
+					<-done
+					pool.loadJournal()
+				}
 			}
 
-			if ev.Block != nil {
-				pool.requestReset(last, ev.Block.Header())
-				head = ev.Block
-			}
+		// System shutdown.
+		case <-pool.chainHeadSub.Err():
+			close(pool.reorgShutdownCh)
+			return
 
 		// Handle stats reporting ticks
 		case <-report.C:
@@ -346,15 +479,18 @@ func (pool *TxPool) loop() {
 				if time.Since(pool.beats[addr]) > pool.config.Lifetime {
 					list := pool.queue[addr].Flatten()
 					for _, tx := range list {
-						pool.removeTx(tx.Hash(), true)
+						pool.removeTx(tx.Hash(), true, DropLifetime)
 					}
 				}
 			}
+			pool.history.expire(pool.config.HistoryLifetime)
+			events := pool.takeTxEvents()
 			pool.mu.Unlock()
+			pool.sendTxEvents(events)
 
 		// Handle local transaction journal rotation
 		case <-journal.C:
-			if pool.journal != nil {
+			if pool.journal != nil && atomic.LoadInt32(&pool.journalReady) == 1 {
 				pool.mu.Lock()
 				if err := pool.journal.rotate(pool.local()); err != nil {
 					log.Warn("Failed to rotate local tx journal", "err", err)
@@ -371,12 +507,30 @@ func (pool *TxPool) Stop() {
 	pool.scope.Close()
 
 	// Unsubscribe subscriptions registered from blockchain
-	// todo: pool.chainHeadSub.Unsubscribe()
+	pool.chainHeadSub.Unsubscribe()
 	pool.wg.Wait()
 
+	// Write the final local transactions, unless the journal was never loaded
 	if pool.journal != nil {
+		if atomic.LoadInt32(&pool.journalReady) == 1 {
+			pool.mu.Lock()
+			if err := pool.journal.rotate(pool.local()); err != nil {
+				log.Warn("Failed to rotate local tx journal", "err", err)
+			}
+			pool.mu.Unlock()
+		}
 		pool.journal.close()
 	}
+	// Write the remote transactions for the next start, unless the last
+	// snapshot was never loaded, it would be overwritten
+	if pool.snapshot != nil && atomic.LoadInt32(&pool.journalReady) == 1 {
+		pool.mu.Lock()
+		if err := pool.snapshot.rotate(pool.remote()); err != nil {
+			log.Warn("Failed to write tx snapshot", "err", err)
+		}
+		pool.mu.Unlock()
+		pool.snapshot.close()
+	}
 	log.Info("Transaction pool stopped")
 }
 
@@ -386,6 +540,64 @@ func (pool *TxPool) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subsc
 	return pool.scope.Track(pool.txFeed.Subscribe(ch))
 }
 
+// SubscribeResyncEvent registers a subscription of ResyncEvent and starts
+// sending event to the given channel.
+func (pool *TxPool) SubscribeResyncEvent(ch chan<- ResyncEvent) event.Subscription {
+	return pool.scope.Track(pool.resyncFeed.Subscribe(ch))
+}
+
+// SubscribeTxLifecycleEvent registers a subscription of TxLifecycleEvent and
+// starts sending the queue, promotion, demotion, replacement, drop and reinject
+// events of transactions to the given channel.
+func (pool *TxPool) SubscribeTxLifecycleEvent(ch chan<- TxLifecycleEvent) event.Subscription {
+	return pool.scope.Track(pool.txEventFeed.Subscribe(ch))
+}
+
+// txEvent records a lifecycle event, the pool lock must be held.
+func (pool *TxPool) txEvent(ev TxEvent) {
+	pool.txEvents = append(pool.txEvents, ev)
+}
+
+// txDropped records the removal of a transaction in the lifecycle events and
+// the history. Transactions removed for their nonce after a block included them
+// are recorded as included. The pool lock must be held.
+func (pool *TxPool) txDropped(addr common.Address, tx *types.Transaction, reason TxDropReason) {
+	hash := tx.Hash()
+	if rec := pool.history.get(hash); rec != nil && rec.Status == TxStatusIncluded && reason == DropNonceTooLow {
+		pool.txEvent(TxEvent{Kind: TxIncluded, Tx: tx, Sender: addr, BlockHash: rec.BlockHash, BlockNumber: rec.BlockNumber})
+		return
+	}
+	// Without the block of the new head it's unknown whether it included the
+	// transaction, forget it rather than report it dropped
+	if pool.headUnchecked && reason == DropNonceTooLow {
+		log.Debug("Transaction left the pool with an unchecked head", "hash", hash)
+		return
+	}
+	pool.history.add(hash, &TxRecord{Status: TxStatusDropped, Reason: reason, Sender: addr, Time: time.Now()}, pool.config.HistorySlots)
+	pool.txEvent(TxEvent{Kind: TxDropped, Tx: tx, Sender: addr, Reason: reason})
+}
+
+// txReplaced records the replacement of a transaction in the lifecycle events
+// and the history. The pool lock must be held.
+func (pool *TxPool) txReplaced(addr common.Address, old, tx *types.Transaction) {
+	pool.history.add(old.Hash(), &TxRecord{Status: TxStatusDropped, Reason: DropReplaced, Sender: addr, Time: time.Now()}, pool.config.HistorySlots)
+	pool.txEvent(TxEvent{Kind: TxReplaced, Tx: old, Sender: addr, ReplacedBy: tx})
+}
+
+// takeTxEvents returns the recorded lifecycle events, the pool lock must be held.
+func (pool *TxPool) takeTxEvents() []TxEvent {
+	events := pool.txEvents
+	pool.txEvents = nil
+	return events
+}
+
+// sendTxEvents sends the lifecycle events, it must be called without the pool lock.
+func (pool *TxPool) sendTxEvents(events []TxEvent) {
+	if len(events) > 0 {
+		pool.txEventFeed.Send(TxLifecycleEvent{Events: events})
+	}
+}
+
 // GasPrice returns the current gas price enforced by the transaction pool.
 func (pool *TxPool) GasPrice() *big.Int {
 	pool.mu.RLock()
@@ -394,12 +606,31 @@ func (pool *TxPool) GasPrice() *big.Int {
 	return new(big.Int).Set(pool.gasPrice)
 }
 
+// BaseFee returns the base fee of the next block which effective tips are
+// computed at, nil before London.
+func (pool *TxPool) BaseFee() *big.Int {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	if pool.priced.urgent.baseFee == nil {
+		return nil
+	}
+	return new(big.Int).Set(pool.priced.urgent.baseFee)
+}
+
 // SetGasPrice updates the minimum price required by the transaction pool for a
 // new transaction, and drops all transactions below this threshold.
 func (pool *TxPool) SetGasPrice(price *big.Int) {
 	pool.mu.Lock()
-	defer pool.mu.Unlock()
+	pool.setGasPrice(price)
+	events := pool.takeTxEvents()
+	pool.mu.Unlock()
+
+	pool.sendTxEvents(events)
+}
 
+// setGasPrice updates the minimum price, the pool lock must be held.
+func (pool *TxPool) setGasPrice(price *big.Int) {
 	old := pool.gasPrice
 	pool.gasPrice = price
 	// if the min miner fee increased, remove transactions below the new threshold
@@ -407,7 +638,7 @@ func (pool *TxPool) SetGasPrice(price *big.Int) {
 		// pool.priced is sorted by GasFeeCap, so we have to iterate through pool.all instead
 		drop := pool.all.RemotesBelowTip(price)
 		for _, tx := range drop {
-			pool.removeTx(tx.Hash(), false)
+			pool.removeTx(tx.Hash(), false, DropBelowPrice)
 		}
 		pool.priced.Removed(len(drop))
 	}
@@ -415,6 +646,64 @@ func (pool *TxPool) SetGasPrice(price *big.Int) {
 	log.Info("Transaction pool price threshold updated", "price", price)
 }
 
+// Config returns the configuration the pool currently runs with.
+func (pool *TxPool) Config() TxPoolConfig {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	return pool.config
+}
+
+// SetConfig sanitizes and applies a new configuration at runtime and returns
+// the applied one. Shrunk limits evict transactions right away, grown ones let
+// queued transactions be promoted. The journal settings are only read at
+// startup and are kept.
+func (pool *TxPool) SetConfig(conf TxPoolConfig) TxPoolConfig {
+	conf = conf.Sanitize()
+
+	pool.mu.Lock()
+	old := pool.config
+	if conf.NoLocals != old.NoLocals || conf.Journal != old.Journal || conf.Rejournal != old.Rejournal || conf.Snapshot != old.Snapshot {
+		log.Warn("Transaction pool journal settings need a restart", "nolocals", conf.NoLocals, "journal", conf.Journal, "rejournal", conf.Rejournal, "snapshot", conf.Snapshot)
+		conf.NoLocals, conf.Journal, conf.Rejournal, conf.Snapshot = old.NoLocals, old.Journal, old.Rejournal, old.Snapshot
+	}
+	pool.config = conf
+
+	if conf.PriceLimit != old.PriceLimit {
+		pool.setGasPrice(new(big.Int).SetUint64(conf.PriceLimit))
+	}
+	pool.truncatePending()
+	pool.truncateQueue()
+	pool.priced.Reheap()
+
+	promote := newAccountSet(pool.signer)
+	for addr := range pool.queue {
+		promote.add(addr)
+	}
+	pool.mu.Unlock()
+
+	if diff := configDiff(old, conf); len(diff) > 0 {
+		log.WithFields(diff).Info("Transaction pool config updated")
+	}
+	pool.requestPromoteExecutables(promote)
+	return conf
+}
+
+// configDiff returns the changed fields with their "old -> new" values.
+func configDiff(old, conf TxPoolConfig) logrus.Fields {
+	var (
+		diff = make(logrus.Fields)
+		ov   = reflect.ValueOf(old)
+		nv   = reflect.ValueOf(conf)
+	)
+	for i := 0; i < ov.NumField(); i++ {
+		if o, n := ov.Field(i).Interface(), nv.Field(i).Interface(); o != n {
+			diff[strings.ToLower(ov.Type().Field(i).Name)] = fmt.Sprintf("%v -> %v", o, n)
+		}
+	}
+	return diff
+}
+
 // Nonce returns the next nonce of an account, with all transactions executable
 // by the pool already applied on top.
 func (pool *TxPool) Nonce(addr common.Address) uint64 {
@@ -433,6 +722,20 @@ func (pool *TxPool) Stats() (int, int) {
 	return pool.stats()
 }
 
+// Accounts returns the number of accounts with pending or queued transactions.
+func (pool *TxPool) Accounts() int {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	accounts := len(pool.pending)
+	for addr := range pool.queue {
+		if _, ok := pool.pending[addr]; !ok {
+			accounts++
+		}
+	}
+	return accounts
+}
+
 // stats retrieves the current pool stats, namely the number of pending and the
 // number of queued (non-executable) transactions.
 func (pool *TxPool) stats() (int, int) {
@@ -536,6 +839,24 @@ func (pool *TxPool) local() map[common.Address]types.Transactions {
 	return txs
 }
 
+// remote retrieves all currently known remote transactions, grouped by origin
+// account and sorted by nonce. The returned transaction set is a copy and can be
+// freely modified by calling code.
+func (pool *TxPool) remote() map[common.Address]types.Transactions {
+	txs := make(map[common.Address]types.Transactions)
+	for addr, pending := range pool.pending {
+		if !pool.locals.contains(addr) {
+			txs[addr] = append(txs[addr], pending.Flatten()...)
+		}
+	}
+	for addr, queued := range pool.queue {
+		if !pool.locals.contains(addr) {
+			txs[addr] = append(txs[addr], queued.Flatten()...)
+		}
+	}
+	return txs
+}
+
 // validateTx checks whether a transaction is valid according to the consensus
 // rules and adheres to some heuristic limits of the local node (price and size).
 func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
@@ -581,12 +902,12 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 		return ErrUnderpriced
 	}
 	// Ensure the transaction adheres to nonce ordering
-	if pool.chainclient.NonceAt(from) > tx.Nonce() {
+	if pool.chain.NonceAt(from) > tx.Nonce() {
 		return core.ErrNonceTooLow
 	}
 	// Transactor should have enough funds to cover the costs
 	// cost == V + GP * GL
-	if pool.chainclient.GetBalance(from).Cmp(tx.Cost()) < 0 {
+	if pool.chain.GetBalance(from).Cmp(tx.Cost()) < 0 {
 		return core.ErrInsufficientFunds
 	}
 	// Ensure the transaction has more gas than the basic tx fee.
@@ -653,7 +974,7 @@ func (pool *TxPool) add(tx *types.Transaction, local bool) (replaced bool, err e
 		// Kick out the underpriced remote transactions.
 		for _, tx := range drop {
 			log.Trace("Discarding freshly underpriced transaction", "hash", tx.Hash(), "gasTipCap", tx.GasTipCap(), "gasFeeCap", tx.GasFeeCap())
-			pool.removeTx(tx.Hash(), false)
+			pool.removeTx(tx.Hash(), false, DropUnderpriced)
 		}
 	}
 	// Try to replace an existing transaction in the pending pool
@@ -668,11 +989,13 @@ func (pool *TxPool) add(tx *types.Transaction, local bool) (replaced bool, err e
 		if old != nil {
 			pool.all.Remove(old.Hash())
 			pool.priced.Removed(1)
+			pool.txReplaced(from, old, tx)
 		}
 		pool.all.Add(tx, isLocal)
 		pool.priced.Put(tx, isLocal)
 		pool.journalTx(from, tx)
 		pool.queueTxEvent(tx)
+		pool.txEvent(TxEvent{Kind: TxPromoted, Tx: tx, Sender: from})
 		log.Trace("Pooled new executable transaction", "hash", hash, "from", from, "to", tx.To())
 
 		// Successful promotion, bump the heartbeat
@@ -691,6 +1014,7 @@ func (pool *TxPool) add(tx *types.Transaction, local bool) (replaced bool, err e
 		pool.priced.Removed(pool.all.RemoteToLocals(pool.locals)) // Migrate the remotes if it's marked as local first time.
 	}
 	pool.journalTx(from, tx)
+	pool.txEvent(TxEvent{Kind: TxQueued, Tx: tx, Sender: from})
 
 	log.Trace("Pooled new future transaction", "hash", hash, "from", from, "to", tx.To())
 	return replaced, nil
@@ -714,6 +1038,7 @@ func (pool *TxPool) enqueueTx(hash common.Hash, tx *types.Transaction, local boo
 	if old != nil {
 		pool.all.Remove(old.Hash())
 		pool.priced.Removed(1)
+		pool.txReplaced(from, old, tx)
 	} else {
 		// Nothing was replaced, bump the queued counter
 	}
@@ -761,15 +1086,18 @@ func (pool *TxPool) promoteTx(addr common.Address, hash common.Hash, tx *types.T
 		// An older transaction was better, discard this
 		pool.all.Remove(hash)
 		pool.priced.Removed(1)
+		pool.txDropped(addr, tx, DropOutbid)
 		return false
 	}
 	// Otherwise discard any previous transaction and mark this
 	if old != nil {
 		pool.all.Remove(old.Hash())
 		pool.priced.Removed(1)
+		pool.txReplaced(addr, old, tx)
 	} else {
 		// Nothing was replaced, bump the pending counter
 	}
+	pool.txEvent(TxEvent{Kind: TxPromoted, Tx: tx, Sender: addr})
 	// Set the potentially new pending nonce and notify any subsystems of the new tx
 	pool.pendingNonces.set(addr, tx.Nonce()+1)
 
@@ -890,13 +1218,17 @@ func (pool *TxPool) addTxsLocked(txs []*types.Transaction, local bool) ([]error,
 	return errs, dirty
 }
 
-// Status returns the status (unknown/pending/queued) of a batch of transactions
-// identified by their hashes.
+// Status returns the status (unknown/pending/queued/included/dropped) of a batch
+// of transactions identified by their hashes. Included and dropped transactions
+// are known for the configured history lifetime.
 func (pool *TxPool) Status(hashes []common.Hash) []TxStatus {
 	status := make([]TxStatus, len(hashes))
 	for i, hash := range hashes {
 		tx := pool.Get(hash)
 		if tx == nil {
+			if rec := pool.Record(hash); rec != nil {
+				status[i] = rec.Status
+			}
 			continue
 		}
 		from, _ := types.Sender(pool.signer, tx) // already validated
@@ -913,6 +1245,22 @@ func (pool *TxPool) Status(hashes []common.Hash) []TxStatus {
 	return status
 }
 
+// Record returns what the pool remembers of a transaction which was included or
+// dropped, nil if it doesn't know the transaction or it is still in the pool.
+func (pool *TxPool) Record(hash common.Hash) *TxRecord {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	if pool.all.Get(hash) != nil {
+		return nil
+	}
+	if rec := pool.history.get(hash); rec != nil {
+		cpy := *rec
+		return &cpy
+	}
+	return nil
+}
+
 // Get returns a transaction if it is contained in the pool and nil otherwise.
 func (pool *TxPool) Get(hash common.Hash) *types.Transaction {
 	return pool.all.Get(hash)
@@ -925,8 +1273,8 @@ func (pool *TxPool) Has(hash common.Hash) bool {
 }
 
 // removeTx removes a single transaction from the queue, moving all subsequent
-// transactions back to the future queue.
-func (pool *TxPool) removeTx(hash common.Hash, outofbound bool) {
+// transactions back to the future queue, and records its drop for the reason.
+func (pool *TxPool) removeTx(hash common.Hash, outofbound bool, reason TxDropReason) {
 	// Fetch the transaction we wish to delete
 	tx := pool.all.Get(hash)
 	if tx == nil {
@@ -939,6 +1287,7 @@ func (pool *TxPool) removeTx(hash common.Hash, outofbound bool) {
 	if outofbound {
 		pool.priced.Removed(1)
 	}
+	pool.txDropped(addr, tx, reason)
 	if pool.locals.contains(addr) {
 
 	}
@@ -953,6 +1302,7 @@ func (pool *TxPool) removeTx(hash common.Hash, outofbound bool) {
 			for _, tx := range invalids {
 				// Internal shuffle shouldn't touch the lookup set.
 				pool.enqueueTx(tx.Hash(), tx, false, false)
+				pool.txEvent(TxEvent{Kind: TxDemoted, Tx: tx, Sender: addr})
 			}
 			// Update the account nonce if needed
 			pool.pendingNonces.setIfLower(addr, tx.Nonce())
@@ -1085,10 +1435,16 @@ func (pool *TxPool) runReorg(done chan struct{}, reset *txpoolResetRequest, dirt
 		// the flatten operation can be avoided.
 		promoteAddrs = dirtyAccounts.flatten()
 	}
+	// Fetch the block of a one block advance before locking, the pool stays
+	// usable while the chain server is slow
+	var headBlock *types.Block
+	if reset != nil && reset.oldHead != nil && reset.newHead != nil && reset.oldHead.Hash() == reset.newHead.ParentHash && pool.all.Count() > 0 {
+		headBlock = pool.headBlock(reset.newHead)
+	}
 	pool.mu.Lock()
 	if reset != nil {
 		// Reset from the old head to the new, rescheduling any reorged transactions
-		pool.reset(reset.oldHead, reset.newHead)
+		pool.reset(reset.oldHead, reset.newHead, headBlock)
 
 		// Nonces were reset, discard any events that became stale
 		for addr := range events {
@@ -1128,8 +1484,36 @@ func (pool *TxPool) runReorg(done chan struct{}, reset *txpoolResetRequest, dirt
 	pool.truncateQueue()
 
 	pool.changesSinceReorg = 0 // Reset change counter
+	pool.headUnchecked = false
+
+	// Summarize the resync if the reset had to do one
+	var resync *ResyncEvent
+	if pool.resync != nil {
+		resync, pool.resync = pool.resync, nil
+		resync.Pending, resync.Queued = pool.stats()
+		resync.Accounts = len(pool.pending)
+		for addr := range pool.queue {
+			if _, ok := pool.pending[addr]; !ok {
+				resync.Accounts++
+			}
+		}
+		// Count the removals, reinjected and promoted transactions don't offset them
+		for _, ev := range pool.txEvents[pool.resyncEvents:] {
+			if ev.Kind == TxDropped {
+				resync.Dropped++
+			}
+		}
+		log.Info("Transaction pool resynced", "head", resync.Head.Number, "depth", resync.Depth,
+			"accounts", resync.Accounts, "pending", resync.Pending, "queued", resync.Queued, "dropped", resync.Dropped)
+	}
+	txEvents := pool.takeTxEvents()
 	pool.mu.Unlock()
 
+	if resync != nil {
+		pool.resyncFeed.Send(*resync)
+	}
+	pool.sendTxEvents(txEvents)
+
 	// Notify subsystems for newly added transactions
 	for _, tx := range promoted {
 		addr, _ := types.Sender(pool.signer, tx)
@@ -1143,29 +1527,38 @@ func (pool *TxPool) runReorg(done chan struct{}, reset *txpoolResetRequest, dirt
 		for _, set := range events {
 			txs = append(txs, set.Flatten()...)
 		}
-		pool.txFeed.Send(core.NewTxsEvent{txs})
+		pool.txFeed.Send(core.NewTxsEvent{Txs: txs})
 	}
 }
 
 // reset retrieves the current state of the blockchain and ensures the content
-// of the transaction pool is valid with regard to the chain state.
-func (pool *TxPool) reset(oldHead, newHead *types.Header) {
+// of the transaction pool is valid with regard to the chain state. headBlock is
+// the block of newHead if it advances the old head by one, nil if it couldn't
+// be fetched.
+func (pool *TxPool) reset(oldHead, newHead *types.Header, headBlock *types.Block) {
 	// If we're reorging an old state, reinject all dropped transactions
-	var reinject types.Transactions
+	var (
+		reinject    types.Transactions
+		added       []*types.Block // New blocks, to record the pool transactions they include
+		resyncDepth uint64
+	)
 
 	if oldHead != nil && oldHead.Hash() != newHead.ParentHash {
 		// If the reorg is too deep, avoid doing it (will happen during fast sync)
 		oldNum := oldHead.Number.Uint64()
 		newNum := newHead.Number.Uint64()
 
-		if depth := uint64(math.Abs(float64(oldNum) - float64(newNum))); depth > 64 {
-			log.Debug("Skipping deep transaction reorg", "depth", depth)
+		if depth := uint64(math.Abs(float64(oldNum) - float64(newNum))); depth > pool.config.MaxReorgDepth {
+			// The reorg is too deep to pull in, rebuild the pool from the new head
+			// instead of trusting any nonce derived from the old chain.
+			log.Warn("Deep transaction reorg, resyncing pool", "depth", depth, "limit", pool.config.MaxReorgDepth)
+			resyncDepth = depth
 		} else {
 			// Reorg seems shallow enough to pull in all transactions into memory
 			var discarded, included types.Transactions
 			var (
-				rem = pool.chainclient.GetBlock(oldHead.Hash(), oldHead.Number.Uint64())
-				add = pool.chainclient.GetBlock(newHead.Hash(), newHead.Number.Uint64())
+				rem = pool.chain.GetHeader(oldHead.Hash(), oldHead.Number.Uint64())
+				add = newHead
 			)
 			if rem == nil {
 				// This can happen if a setHead is performed, where we simply discard the old
@@ -1183,53 +1576,94 @@ func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 					"old", oldHead.Hash(), "oldnum", oldNum, "new", newHead.Hash(), "newnum", newNum)
 				// We still need to update the current state s.th. the lost transactions can be readded by the user
 			} else {
-				for rem.NumberU64() > add.NumberU64() {
-					discarded = append(discarded, rem.Transactions()...)
-					if rem = pool.chainclient.GetBlock(rem.ParentHash(), rem.NumberU64()-1); rem == nil {
+				// Walk the headers back to the common ancestor first, block bodies are
+				// only fetched for the blocks that were really reorged.
+				var remHeaders, addHeaders []*types.Header
+				for rem.Number.Uint64() > add.Number.Uint64() {
+					remHeaders = append(remHeaders, rem)
+					if rem = pool.chain.GetHeader(rem.ParentHash, rem.Number.Uint64()-1); rem == nil {
 						log.Error("Unrooted old chain seen by tx pool", "block", oldHead.Number, "hash", oldHead.Hash())
 						return
 					}
 				}
-				for add.NumberU64() > rem.NumberU64() {
-					included = append(included, add.Transactions()...)
-					if add = pool.chainclient.GetBlock(add.ParentHash(), add.NumberU64()-1); add == nil {
+				for add.Number.Uint64() > rem.Number.Uint64() {
+					addHeaders = append(addHeaders, add)
+					if add = pool.chain.GetHeader(add.ParentHash, add.Number.Uint64()-1); add == nil {
 						log.Error("Unrooted new chain seen by tx pool", "block", newHead.Number, "hash", newHead.Hash())
 						return
 					}
 				}
 				for rem.Hash() != add.Hash() {
-					discarded = append(discarded, rem.Transactions()...)
-					if rem = pool.chainclient.GetBlock(rem.ParentHash(), rem.NumberU64()-1); rem == nil {
+					remHeaders = append(remHeaders, rem)
+					if rem = pool.chain.GetHeader(rem.ParentHash, rem.Number.Uint64()-1); rem == nil {
 						log.Error("Unrooted old chain seen by tx pool", "block", oldHead.Number, "hash", oldHead.Hash())
 						return
 					}
-					included = append(included, add.Transactions()...)
-					if add = pool.chainclient.GetBlock(add.ParentHash(), add.NumberU64()-1); add == nil {
+					addHeaders = append(addHeaders, add)
+					if add = pool.chain.GetHeader(add.ParentHash, add.Number.Uint64()-1); add == nil {
 						log.Error("Unrooted new chain seen by tx pool", "block", newHead.Number, "hash", newHead.Hash())
 						return
 					}
 				}
-				reinject = types.TxDifference(discarded, included)
+				var ok bool
+				if added, ok = pool.blocks(addHeaders); !ok {
+					log.Error("Unrooted new chain seen by tx pool", "block", newHead.Number, "hash", newHead.Hash())
+					return
+				}
+				for _, block := range added {
+					included = append(included, block.Transactions()...)
+				}
+				if discarded, ok = pool.blockTransactions(remHeaders); !ok {
+					// The old bodies are gone, same as a missing old head above.
+					if newNum >= oldNum {
+						log.Warn("Transaction pool reset with missing old blocks",
+							"old", oldHead.Hash(), "oldnum", oldNum, "new", newHead.Hash(), "newnum", newNum)
+						return
+					}
+					log.Debug("Skipping transaction reset caused by setHead",
+						"old", oldHead.Hash(), "oldnum", oldNum, "new", newHead.Hash(), "newnum", newNum)
+				} else {
+					reinject = types.TxDifference(discarded, included)
+				}
 			}
 		}
+	} else if oldHead != nil && pool.all.Count() > 0 {
+		// The head advanced by one block, its block tells which pool transactions it includes
+		if headBlock != nil {
+			added = []*types.Block{headBlock}
+		} else {
+			pool.headUnchecked = true
+		}
 	}
 	// Initialize the internal state to the current head
 	if newHead == nil {
-		current, _ := pool.chainclient.CurrentBlock() // Special case during testing
+		current, _ := pool.chain.CurrentHeader() // Special case during testing
 		if current != nil {
-			newHead = current.Header()
+			newHead = current
 		} else {
 			log.Error(" newhead is not set, run out reset.")
 			return
 		}
 	}
-	pool.pendingNonces = newTxNoncer(newHead.Number, pool.chainclient)
+	pool.pendingNonces = newTxNoncer(newHead.Number, pool.chain)
 	pool.currentMaxGas = newHead.GasLimit
+	atomic.StoreInt32(&pool.headReady, 1)
+
+	if resyncDepth > 0 {
+		pool.resyncAll(newHead, resyncDepth)
+	}
 
 	// Inject any transactions discarded due to reorgs
 	log.Debug("Reinjecting stale transactions", "count", len(reinject))
 	senderCacher.recover(pool.signer, reinject)
-	pool.addTxsLocked(reinject, false)
+	pool.recordIncluded(added, reinject)
+	errs, _ := pool.addTxsLocked(reinject, false)
+	for i, tx := range reinject {
+		if errs[i] == nil {
+			addr, _ := types.Sender(pool.signer, tx)
+			pool.txEvent(TxEvent{Kind: TxReinjected, Tx: tx, Sender: addr})
+		}
+	}
 
 	// Update all fork indicator by next pending block number.
 	next := new(big.Int).Add(newHead.Number, big.NewInt(1))
@@ -1238,6 +1672,117 @@ func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 	pool.eip1559 = pool.chainconfig.IsLondon(next)
 }
 
+// resyncAll moves every pending transaction back to the queue, so that the
+// promotion following the reset rebuilds the pending set from the nonces and
+// balances of the new head.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *TxPool) resyncAll(head *types.Header, depth uint64) {
+	pool.resyncEvents = len(pool.txEvents)
+	for addr, list := range pool.pending {
+		for _, tx := range list.Flatten() {
+			hash := tx.Hash()
+			// Internal shuffle shouldn't touch the lookup set.
+			if _, err := pool.enqueueTx(hash, tx, false, false); err != nil {
+				pool.all.Remove(hash)
+				pool.priced.Removed(1)
+				pool.txDropped(addr, tx, DropResync)
+				continue
+			}
+			pool.txEvent(TxEvent{Kind: TxDemoted, Tx: tx, Sender: addr})
+		}
+		delete(pool.pending, addr)
+	}
+	pool.resync = &ResyncEvent{
+		Head:  head,
+		Depth: depth,
+	}
+}
+
+// recordIncluded remembers the blocks including transactions of the pool, or
+// already recorded as included, before they are removed for their nonce. The
+// records of reorged transactions which aren't included anymore are removed.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *TxPool) recordIncluded(blocks []*types.Block, reorged types.Transactions) {
+	for _, tx := range reorged {
+		hash := tx.Hash()
+		if rec := pool.history.get(hash); rec != nil && rec.Status == TxStatusIncluded {
+			addr, _ := types.Sender(pool.signer, tx)
+			pool.txEvent(TxEvent{Kind: TxReorged, Tx: tx, Sender: addr, BlockHash: rec.BlockHash, BlockNumber: rec.BlockNumber})
+		}
+		pool.history.remove(hash)
+	}
+	now := time.Now()
+	for _, block := range blocks {
+		for _, tx := range block.Transactions() {
+			hash := tx.Hash()
+			rec := pool.history.get(hash)
+			if pool.all.Get(hash) == nil && (rec == nil || rec.Status != TxStatusIncluded) {
+				continue
+			}
+			// Transactions left in the pool are reported included once removed
+			if rec != nil && rec.Status == TxStatusIncluded && rec.BlockHash != block.Hash() {
+				addr, _ := types.Sender(pool.signer, tx)
+				pool.txEvent(TxEvent{Kind: TxIncluded, Tx: tx, Sender: addr, BlockHash: block.Hash(), BlockNumber: block.NumberU64()})
+			}
+			pool.history.add(hash, &TxRecord{
+				Status:      TxStatusIncluded,
+				BlockHash:   block.Hash(),
+				BlockNumber: block.NumberU64(),
+				Time:        now,
+			}, pool.config.HistorySlots)
+		}
+	}
+}
+
+// headBlock fetches the block of the new head, retrying a few times. It returns
+// nil if the block stays unavailable or the pool is stopping.
+func (pool *TxPool) headBlock(head *types.Header) *types.Block {
+	for i := 0; ; i++ {
+		if blocks, ok := pool.blocks([]*types.Header{head}); ok {
+			return blocks[0]
+		}
+		if i == headBlockRetries-1 {
+			log.Warn("Failed to fetch new head block, its transactions aren't recorded", "number", head.Number, "hash", head.Hash())
+			return nil
+		}
+		select {
+		case <-time.After(headBlockRetryDelay):
+		case <-pool.reorgShutdownCh:
+			return nil
+		}
+	}
+}
+
+// blocks fetches the bodies of the given chain segment, ordered from newest to
+// oldest. It reports false if any of the bodies is unavailable.
+func (pool *TxPool) blocks(headers []*types.Header) ([]*types.Block, bool) {
+	if len(headers) == 0 {
+		return nil, true
+	}
+	blocks := pool.chain.GetBlocks(headers[0].Hash(), headers[0].Number.Uint64(), uint64(len(headers)))
+	if len(blocks) != len(headers) {
+		return nil, false
+	}
+	return blocks, true
+}
+
+// blockTransactions fetches the bodies of the given chain segment, ordered from
+// newest to oldest, and returns all the transactions contained. It reports false
+// if any of the bodies is unavailable.
+func (pool *TxPool) blockTransactions(headers []*types.Header) (types.Transactions, bool) {
+	blocks, ok := pool.blocks(headers)
+	if !ok {
+		return nil, false
+	}
+	var txs types.Transactions
+	for _, block := range blocks {
+		txs = append(txs, block.Transactions()...)
+	}
+	return txs, true
+}
+
 // promoteExecutables moves transactions that have become processable from the
 // future queue to the set of pending transactions. During this process, all
 // invalidated transactions (low nonce, low balance) are deleted.
@@ -1252,17 +1797,19 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) []*types.Trans
 			continue // Just in case someone calls with a non existing account
 		}
 		// Drop all transactions that are deemed too old (low nonce)
-		forwards := list.Forward(pool.chainclient.NonceAt(addr))
+		forwards := list.Forward(pool.chain.NonceAt(addr))
 		for _, tx := range forwards {
 			hash := tx.Hash()
 			pool.all.Remove(hash)
+			pool.txDropped(addr, tx, DropNonceTooLow)
 		}
 		log.Trace("Removed old queued transactions", "count", len(forwards))
 		// Drop all transactions that are too costly (low balance or out of gas)
-		drops, _ := list.Filter(pool.chainclient.GetBalance(addr), pool.currentMaxGas)
+		drops, _ := list.Filter(pool.chain.GetBalance(addr), pool.currentMaxGas)
 		for _, tx := range drops {
 			hash := tx.Hash()
 			pool.all.Remove(hash)
+			pool.txDropped(addr, tx, DropUnpayable)
 		}
 		log.Trace("Removed unpayable queued transactions", "count", len(drops))
 
@@ -1283,6 +1830,7 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) []*types.Trans
 			for _, tx := range caps {
 				hash := tx.Hash()
 				pool.all.Remove(hash)
+				pool.txDropped(addr, tx, DropAccountLimit)
 				log.Trace("Removed cap-exceeding queued transaction", "hash", hash)
 			}
 		}
@@ -1344,6 +1892,7 @@ func (pool *TxPool) truncatePending() {
 
 						// Update the account nonce to the dropped transaction
 						pool.pendingNonces.setIfLower(offenders[i], tx.Nonce())
+						pool.txDropped(offenders[i], tx, DropPendingLimit)
 						log.Trace("Removed fairness-exceeding pending transaction", "hash", hash)
 					}
 					pool.priced.Removed(len(caps))
@@ -1369,6 +1918,7 @@ func (pool *TxPool) truncatePending() {
 
 					// Update the account nonce to the dropped transaction
 					pool.pendingNonces.setIfLower(addr, tx.Nonce())
+					pool.txDropped(addr, tx, DropPendingLimit)
 					log.Trace("Removed fairness-exceeding pending transaction", "hash", hash)
 				}
 				pool.priced.Removed(len(caps))
@@ -1409,7 +1959,7 @@ func (pool *TxPool) truncateQueue() {
 		// Drop all transactions if they are less than the overflow
 		if size := uint64(list.Len()); size <= drop {
 			for _, tx := range list.Flatten() {
-				pool.removeTx(tx.Hash(), true)
+				pool.removeTx(tx.Hash(), true, DropQueueLimit)
 			}
 			drop -= size
 			continue
@@ -1417,7 +1967,7 @@ func (pool *TxPool) truncateQueue() {
 		// Otherwise drop only last few transactions
 		txs := list.Flatten()
 		for i := len(txs) - 1; i >= 0 && drop > 0; i-- {
-			pool.removeTx(txs[i].Hash(), true)
+			pool.removeTx(txs[i].Hash(), true, DropQueueLimit)
 			drop--
 		}
 	}
@@ -1433,21 +1983,23 @@ func (pool *TxPool) truncateQueue() {
 func (pool *TxPool) demoteUnexecutables() {
 	// Iterate over all accounts and demote any non-executable transactions
 	for addr, list := range pool.pending {
-		nonce := pool.chainclient.NonceAt(addr)
+		nonce := pool.chain.NonceAt(addr)
 
 		// Drop all transactions that are deemed too old (low nonce)
 		olds := list.Forward(nonce)
 		for _, tx := range olds {
 			hash := tx.Hash()
 			pool.all.Remove(hash)
+			pool.txDropped(addr, tx, DropNonceTooLow)
 			log.Trace("Removed old pending transaction", "hash", hash)
 		}
 		// Drop all transactions that are too costly (low balance or out of gas), and queue any invalids back for later
-		drops, invalids := list.Filter(pool.chainclient.GetBalance(addr), pool.currentMaxGas)
+		drops, invalids := list.Filter(pool.chain.GetBalance(addr), pool.currentMaxGas)
 		for _, tx := range drops {
 			hash := tx.Hash()
 			log.Trace("Removed unpayable pending transaction", "hash", hash)
 			pool.all.Remove(hash)
+			pool.txDropped(addr, tx, DropUnpayable)
 		}
 
 		for _, tx := range invalids {
@@ -1456,6 +2008,7 @@ func (pool *TxPool) demoteUnexecutables() {
 
 			// Internal shuffle shouldn't touch the lookup set.
 			pool.enqueueTx(hash, tx, false, false)
+			pool.txEvent(TxEvent{Kind: TxDemoted, Tx: tx, Sender: addr})
 		}
 		if pool.locals.contains(addr) {
 		}
@@ -1468,6 +2021,7 @@ func (pool *TxPool) demoteUnexecutables() {
 
 				// Internal shuffle shouldn't touch the lookup set.
 				pool.enqueueTx(hash, tx, false, false)
+				pool.txEvent(TxEvent{Kind: TxDemoted, Tx: tx, Sender: addr})
 			}
 			// This might happen in a reorg, so log it to the metering
 		}
@@ -82,7 +82,7 @@ func Warningf(format string, args ...interface{}) {
 }
 
 func Errorf(format string, args ...interface{}) {
-	mlog.Errorf(format, args)
+	mlog.Errorf(format, args...)
 }
 
 func Fatalf(format string, args ...interface{}) {
@@ -50,6 +50,21 @@ func GenerateDB(path string) *SecretDb {
 	return db
 }
 
+// DevDB creates a secret db for dev mode from the given key, or from a fresh
+// key if none is given. It is never sealed to disk.
+func DevDB(hexk string) (*SecretDb, error) {
+	if len(hexk) > 0 {
+		return CreateWithHexkey(hexk)
+	}
+	pk := cryptor.GenerateKey()
+	hexk = common.Bytes2Hex(crypto.FromECDSA(pk.ExportECDSA()))
+	log.WithField("pk", hexk).Info("generate dev private key")
+	return &SecretDb{
+		PK:    hexk,
+		privk: pk,
+	}, nil
+}
+
 func LoadDb(path string) *SecretDb {
 	// read file content
 	data, err := os.ReadFile(path)
@@ -2,13 +2,14 @@ package node
 
 import (
 	ethcmn "github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/params"
 	"github.com/trusted-defi/trusted-engine/config"
+	"github.com/trusted-defi/trusted-engine/core/chainclient"
 	"github.com/trusted-defi/trusted-engine/core/mempool"
 	"github.com/trusted-defi/trusted-engine/log"
 	"github.com/trusted-defi/trusted-engine/smanager"
-	"math/big"
+	"os"
 	"path/filepath"
+	"sync"
 )
 
 const (
@@ -16,30 +17,49 @@ const (
 )
 
 type Node struct {
+	chain    *chainclient.ChainClient
 	txpool   *mempool.TxPool
+	sdbMu    sync.RWMutex // Guards sdb, the key manager sets it when a key arrives
 	sdb      *SecretDb
 	kmanager *smanager.KeyManager
+	nodedir  string
 }
 
-func init() {
-	// todo: set chainid with chain client.
-	updateConfig()
-}
-
-var (
-	chainConfig = params.MainnetChainConfig
-)
-
-func updateConfig() {
-	chainConfig.ChainID = big.NewInt(1024)
-}
-
-func NewNode(nodeconfig config.NodeConfig) *Node {
+func NewNode(nodeconfig config.NodeConfig, poolconfig mempool.TxPoolConfig) (*Node, error) {
+	var err error
 	n := new(Node)
-	n.txpool = mempool.NewTxPool(mempool.DefaultTxPoolConfig, chainConfig, nodeconfig)
+	// Chain config is taken from genesis file or network preset if given,
+	// otherwise the pool uses the one announced by chain server. Its chain id
+	// is expected from chain server unless one is configured, which must agree.
+	chainConfig, err := nodeconfig.ChainConfig()
+	if err != nil {
+		return nil, err
+	}
+	if chainConfig != nil && nodeconfig.ChainID == 0 {
+		nodeconfig.ChainID = chainConfig.ChainID.Uint64()
+	}
+	n.chain, err = chainclient.NewChainClient(nodeconfig)
+	if err != nil {
+		log.WithField("err", err).Error("create chain client failed")
+		return nil, err
+	}
+	if err := os.MkdirAll(nodeconfig.NodeDir, 0700); err != nil {
+		return nil, err
+	}
+	n.nodedir = nodeconfig.NodeDir
+	poolconfig.Journal = n.resolvePath(poolconfig.Journal)
+	poolconfig.Snapshot = n.resolvePath(poolconfig.Snapshot)
+	n.txpool, err = mempool.NewTxPool(poolconfig, chainConfig, n.chain)
+	if err != nil {
+		return nil, err
+	}
 	sdbpath := filepath.Join(nodeconfig.NodeDir, dbfile)
-	var err error
-	if nodeconfig.Generate {
+	if nodeconfig.Dev {
+		n.sdb, err = DevDB(nodeconfig.GivenPrivate)
+		if err != nil {
+			return nil, err
+		}
+	} else if nodeconfig.Generate {
 		n.sdb = GenerateDB(sdbpath)
 	} else {
 		if len(nodeconfig.GivenPrivate) > 0 {
@@ -60,22 +80,71 @@ func NewNode(nodeconfig config.NodeConfig) *Node {
 	}
 	n.kmanager.AddKeyWatcher(n.WatchKey)
 
-	return n
+	return n, nil
+}
+
+// Stop stops the pool, which writes its journal, and closes the chain client.
+func (n *Node) Stop() {
+	n.txpool.Stop()
+	n.chain.Close()
+}
+
+// SetTxPoolConfig applies a pool configuration at runtime, the journal and
+// snapshot are resolved against the node dir like at startup.
+func (n *Node) SetTxPoolConfig(conf mempool.TxPoolConfig) mempool.TxPoolConfig {
+	conf.Journal = n.resolvePath(conf.Journal)
+	conf.Snapshot = n.resolvePath(conf.Snapshot)
+	return n.txpool.SetConfig(conf)
+}
+
+// resolvePath returns a path relative to the node dir, absolute and empty
+// paths are kept.
+func (n *Node) resolvePath(path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(n.nodedir, path)
 }
 
 func (n *Node) TxPool() *mempool.TxPool {
 	return n.txpool
 }
 
+// Chain returns the client of the chain server the pool follows.
+func (n *Node) Chain() *chainclient.ChainClient {
+	return n.chain
+}
+
+// Readiness returns the reasons why the node can't serve requests yet, empty
+// when it is ready.
+func (n *Node) Readiness() []string {
+	var reasons []string
+	if n.GetSecretDB() == nil {
+		reasons = append(reasons, "no secret key")
+	}
+	if err := n.chain.Refused(); err != nil {
+		reasons = append(reasons, "chain server refused: "+err.Error())
+	} else if !n.chain.Connected() {
+		if err := n.chain.TLSError(); err != nil {
+			reasons = append(reasons, err.Error())
+		} else {
+			reasons = append(reasons, "chain server not connected")
+		}
+	}
+	return append(reasons, n.txpool.Readiness()...)
+}
+
 func (n *Node) IsReady() bool {
-	return n.txpool.IsReady()
+	return len(n.Readiness()) == 0
 }
 
 func (n *Node) SetPrivk(hexk string) error {
 	if sdb, err := CreateWithHexkey(hexk); err != nil {
 		return err
 	} else {
+		n.sdbMu.Lock()
 		n.sdb = sdb
+		n.sdbMu.Unlock()
 	}
 	return nil
 }
@@ -85,6 +154,8 @@ func (n *Node) WatchKey(key []byte) {
 }
 
 func (n *Node) GetSecretDB() *SecretDb {
+	n.sdbMu.RLock()
+	defer n.sdbMu.RUnlock()
 	return n.sdb
 }
 
@@ -6,7 +6,8 @@ package trustedv1
 import (
 	fmt "fmt"
 	proto "github.com/gogo/protobuf/proto"
-	_ "google.golang.org/protobuf/types/known/fieldmaskpb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	math "math"
 )
@@ -22,8 +23,289 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TxState selects pending or queued transactions, any selects both.
+type TxState int32
+
+const (
+	TxState_TX_STATE_ANY     TxState = 0
+	TxState_TX_STATE_PENDING TxState = 1
+	TxState_TX_STATE_QUEUED  TxState = 2
+)
+
+var TxState_name = map[int32]string{
+	0: "TX_STATE_ANY",
+	1: "TX_STATE_PENDING",
+	2: "TX_STATE_QUEUED",
+}
+
+var TxState_value = map[string]int32{
+	"TX_STATE_ANY":     0,
+	"TX_STATE_PENDING": 1,
+	"TX_STATE_QUEUED":  2,
+}
+
+func (x TxState) String() string {
+	return proto.EnumName(TxState_name, int32(x))
+}
+
+func (TxState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{0}
+}
+
+// TxOrigin selects transactions of local or remote senders, any selects both.
+type TxOrigin int32
+
+const (
+	TxOrigin_TX_ORIGIN_ANY    TxOrigin = 0
+	TxOrigin_TX_ORIGIN_LOCAL  TxOrigin = 1
+	TxOrigin_TX_ORIGIN_REMOTE TxOrigin = 2
+)
+
+var TxOrigin_name = map[int32]string{
+	0: "TX_ORIGIN_ANY",
+	1: "TX_ORIGIN_LOCAL",
+	2: "TX_ORIGIN_REMOTE",
+}
+
+var TxOrigin_value = map[string]int32{
+	"TX_ORIGIN_ANY":    0,
+	"TX_ORIGIN_LOCAL":  1,
+	"TX_ORIGIN_REMOTE": 2,
+}
+
+func (x TxOrigin) String() string {
+	return proto.EnumName(TxOrigin_name, int32(x))
+}
+
+func (TxOrigin) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{1}
+}
+
+// TxErrorCode is why a transaction wasn't added to the pool.
+type TxErrorCode int32
+
+const (
+	TxErrorCode_TX_ERROR_NONE                TxErrorCode = 0
+	TxErrorCode_TX_ERROR_MALFORMED           TxErrorCode = 1
+	TxErrorCode_TX_ERROR_DECRYPT             TxErrorCode = 2
+	TxErrorCode_TX_ERROR_ALREADY_KNOWN       TxErrorCode = 3
+	TxErrorCode_TX_ERROR_INVALID_SENDER      TxErrorCode = 4
+	TxErrorCode_TX_ERROR_UNDERPRICED         TxErrorCode = 5
+	TxErrorCode_TX_ERROR_REPLACE_UNDERPRICED TxErrorCode = 6
+	TxErrorCode_TX_ERROR_POOL_OVERFLOW       TxErrorCode = 7
+	TxErrorCode_TX_ERROR_GAS_LIMIT           TxErrorCode = 8
+	TxErrorCode_TX_ERROR_NEGATIVE_VALUE      TxErrorCode = 9
+	TxErrorCode_TX_ERROR_OVERSIZED_DATA      TxErrorCode = 10
+	TxErrorCode_TX_ERROR_NONCE_TOO_LOW       TxErrorCode = 11
+	TxErrorCode_TX_ERROR_INSUFFICIENT_FUNDS  TxErrorCode = 12
+	TxErrorCode_TX_ERROR_INTRINSIC_GAS       TxErrorCode = 13
+	TxErrorCode_TX_ERROR_TYPE_NOT_SUPPORTED  TxErrorCode = 14
+	TxErrorCode_TX_ERROR_FEE_CAP_VERY_HIGH   TxErrorCode = 15
+	TxErrorCode_TX_ERROR_TIP_VERY_HIGH       TxErrorCode = 16
+	TxErrorCode_TX_ERROR_TIP_ABOVE_FEE_CAP   TxErrorCode = 17
+	TxErrorCode_TX_ERROR_INTERNAL            TxErrorCode = 18
+)
+
+var TxErrorCode_name = map[int32]string{
+	0:  "TX_ERROR_NONE",
+	1:  "TX_ERROR_MALFORMED",
+	2:  "TX_ERROR_DECRYPT",
+	3:  "TX_ERROR_ALREADY_KNOWN",
+	4:  "TX_ERROR_INVALID_SENDER",
+	5:  "TX_ERROR_UNDERPRICED",
+	6:  "TX_ERROR_REPLACE_UNDERPRICED",
+	7:  "TX_ERROR_POOL_OVERFLOW",
+	8:  "TX_ERROR_GAS_LIMIT",
+	9:  "TX_ERROR_NEGATIVE_VALUE",
+	10: "TX_ERROR_OVERSIZED_DATA",
+	11: "TX_ERROR_NONCE_TOO_LOW",
+	12: "TX_ERROR_INSUFFICIENT_FUNDS",
+	13: "TX_ERROR_INTRINSIC_GAS",
+	14: "TX_ERROR_TYPE_NOT_SUPPORTED",
+	15: "TX_ERROR_FEE_CAP_VERY_HIGH",
+	16: "TX_ERROR_TIP_VERY_HIGH",
+	17: "TX_ERROR_TIP_ABOVE_FEE_CAP",
+	18: "TX_ERROR_INTERNAL",
+}
+
+var TxErrorCode_value = map[string]int32{
+	"TX_ERROR_NONE":                0,
+	"TX_ERROR_MALFORMED":           1,
+	"TX_ERROR_DECRYPT":             2,
+	"TX_ERROR_ALREADY_KNOWN":       3,
+	"TX_ERROR_INVALID_SENDER":      4,
+	"TX_ERROR_UNDERPRICED":         5,
+	"TX_ERROR_REPLACE_UNDERPRICED": 6,
+	"TX_ERROR_POOL_OVERFLOW":       7,
+	"TX_ERROR_GAS_LIMIT":           8,
+	"TX_ERROR_NEGATIVE_VALUE":      9,
+	"TX_ERROR_OVERSIZED_DATA":      10,
+	"TX_ERROR_NONCE_TOO_LOW":       11,
+	"TX_ERROR_INSUFFICIENT_FUNDS":  12,
+	"TX_ERROR_INTRINSIC_GAS":       13,
+	"TX_ERROR_TYPE_NOT_SUPPORTED":  14,
+	"TX_ERROR_FEE_CAP_VERY_HIGH":   15,
+	"TX_ERROR_TIP_VERY_HIGH":       16,
+	"TX_ERROR_TIP_ABOVE_FEE_CAP":   17,
+	"TX_ERROR_INTERNAL":            18,
+}
+
+func (x TxErrorCode) String() string {
+	return proto.EnumName(TxErrorCode_name, int32(x))
+}
+
+func (TxErrorCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{2}
+}
+
+// TxEventKind is the change of a transaction in the pool.
+type TxEventKind int32
+
+const (
+	TxEventKind_TX_EVENT_UNKNOWN    TxEventKind = 0
+	TxEventKind_TX_EVENT_QUEUED     TxEventKind = 1
+	TxEventKind_TX_EVENT_PROMOTED   TxEventKind = 2
+	TxEventKind_TX_EVENT_DEMOTED    TxEventKind = 3
+	TxEventKind_TX_EVENT_REPLACED   TxEventKind = 4
+	TxEventKind_TX_EVENT_DROPPED    TxEventKind = 5
+	TxEventKind_TX_EVENT_REINJECTED TxEventKind = 6
+	TxEventKind_TX_EVENT_INCLUDED   TxEventKind = 7
+	TxEventKind_TX_EVENT_REORGED    TxEventKind = 8
+)
+
+var TxEventKind_name = map[int32]string{
+	0: "TX_EVENT_UNKNOWN",
+	1: "TX_EVENT_QUEUED",
+	2: "TX_EVENT_PROMOTED",
+	3: "TX_EVENT_DEMOTED",
+	4: "TX_EVENT_REPLACED",
+	5: "TX_EVENT_DROPPED",
+	6: "TX_EVENT_REINJECTED",
+	7: "TX_EVENT_INCLUDED",
+	8: "TX_EVENT_REORGED",
+}
+
+var TxEventKind_value = map[string]int32{
+	"TX_EVENT_UNKNOWN":    0,
+	"TX_EVENT_QUEUED":     1,
+	"TX_EVENT_PROMOTED":   2,
+	"TX_EVENT_DEMOTED":    3,
+	"TX_EVENT_REPLACED":   4,
+	"TX_EVENT_DROPPED":    5,
+	"TX_EVENT_REINJECTED": 6,
+	"TX_EVENT_INCLUDED":   7,
+	"TX_EVENT_REORGED":    8,
+}
+
+func (x TxEventKind) String() string {
+	return proto.EnumName(TxEventKind_name, int32(x))
+}
+
+func (TxEventKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{3}
+}
+
+// TxDropReason tells why a transaction was dropped.
+type TxDropReason int32
+
+const (
+	TxDropReason_TX_DROP_NONE          TxDropReason = 0
+	TxDropReason_TX_DROP_UNDERPRICED   TxDropReason = 1
+	TxDropReason_TX_DROP_BELOW_PRICE   TxDropReason = 2
+	TxDropReason_TX_DROP_LIFETIME      TxDropReason = 3
+	TxDropReason_TX_DROP_NONCE_TOO_LOW TxDropReason = 4
+	TxDropReason_TX_DROP_UNPAYABLE     TxDropReason = 5
+	TxDropReason_TX_DROP_ACCOUNT_LIMIT TxDropReason = 6
+	TxDropReason_TX_DROP_PENDING_LIMIT TxDropReason = 7
+	TxDropReason_TX_DROP_QUEUE_LIMIT   TxDropReason = 8
+	TxDropReason_TX_DROP_OUTBID        TxDropReason = 9
+	TxDropReason_TX_DROP_RESYNC        TxDropReason = 10
+	TxDropReason_TX_DROP_REPLACED      TxDropReason = 11
+)
+
+var TxDropReason_name = map[int32]string{
+	0:  "TX_DROP_NONE",
+	1:  "TX_DROP_UNDERPRICED",
+	2:  "TX_DROP_BELOW_PRICE",
+	3:  "TX_DROP_LIFETIME",
+	4:  "TX_DROP_NONCE_TOO_LOW",
+	5:  "TX_DROP_UNPAYABLE",
+	6:  "TX_DROP_ACCOUNT_LIMIT",
+	7:  "TX_DROP_PENDING_LIMIT",
+	8:  "TX_DROP_QUEUE_LIMIT",
+	9:  "TX_DROP_OUTBID",
+	10: "TX_DROP_RESYNC",
+	11: "TX_DROP_REPLACED",
+}
+
+var TxDropReason_value = map[string]int32{
+	"TX_DROP_NONE":          0,
+	"TX_DROP_UNDERPRICED":   1,
+	"TX_DROP_BELOW_PRICE":   2,
+	"TX_DROP_LIFETIME":      3,
+	"TX_DROP_NONCE_TOO_LOW": 4,
+	"TX_DROP_UNPAYABLE":     5,
+	"TX_DROP_ACCOUNT_LIMIT": 6,
+	"TX_DROP_PENDING_LIMIT": 7,
+	"TX_DROP_QUEUE_LIMIT":   8,
+	"TX_DROP_OUTBID":        9,
+	"TX_DROP_RESYNC":        10,
+	"TX_DROP_REPLACED":      11,
+}
+
+func (x TxDropReason) String() string {
+	return proto.EnumName(TxDropReason_name, int32(x))
+}
+
+func (TxDropReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{4}
+}
+
+// TxWatchStatus is the state of a watched transaction.
+type TxWatchStatus int32
+
+const (
+	TxWatchStatus_TX_WATCH_UNKNOWN  TxWatchStatus = 0
+	TxWatchStatus_TX_WATCH_QUEUED   TxWatchStatus = 1
+	TxWatchStatus_TX_WATCH_PENDING  TxWatchStatus = 2
+	TxWatchStatus_TX_WATCH_REPLACED TxWatchStatus = 3
+	TxWatchStatus_TX_WATCH_DROPPED  TxWatchStatus = 4
+	TxWatchStatus_TX_WATCH_INCLUDED TxWatchStatus = 5
+	TxWatchStatus_TX_WATCH_REORGED  TxWatchStatus = 6
+)
+
+var TxWatchStatus_name = map[int32]string{
+	0: "TX_WATCH_UNKNOWN",
+	1: "TX_WATCH_QUEUED",
+	2: "TX_WATCH_PENDING",
+	3: "TX_WATCH_REPLACED",
+	4: "TX_WATCH_DROPPED",
+	5: "TX_WATCH_INCLUDED",
+	6: "TX_WATCH_REORGED",
+}
+
+var TxWatchStatus_value = map[string]int32{
+	"TX_WATCH_UNKNOWN":  0,
+	"TX_WATCH_QUEUED":   1,
+	"TX_WATCH_PENDING":  2,
+	"TX_WATCH_REPLACED": 3,
+	"TX_WATCH_DROPPED":  4,
+	"TX_WATCH_INCLUDED": 5,
+	"TX_WATCH_REORGED":  6,
+}
+
+func (x TxWatchStatus) String() string {
+	return proto.EnumName(TxWatchStatus_name, int32(x))
+}
+
+func (TxWatchStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{5}
+}
+
 type ServiceReadyResponse struct {
-	Ready                bool     `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	Ready bool `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	// Reasons why the service is not ready, empty when ready.
+	Reasons              []string `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -60,6 +342,13 @@ func (m *ServiceReadyResponse) GetReady() bool {
 	return false
 }
 
+func (m *ServiceReadyResponse) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
 type SetPriceRequest struct {
 	Price                []byte   `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -136,18 +425,86 @@ func (m *GasPriceResponse) GetPrice() []byte {
 	return nil
 }
 
-type PendingNonceRequest struct {
-	Address              []byte   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+// OwnerAuth proves a query about the transactions of an account comes from its
+// owner. The owner signs as an EIP-191 personal message the keccak256 of
+// "trusted-engine owner query", the method name and a zero byte, the queried
+// subject (tx hash or address), nonce and expiry as 8 byte big endian, and
+// reply_key.
+type OwnerAuth struct {
+	Nonce                uint64   `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Expiry               uint64   `protobuf:"varint,2,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	ReplyKey             []byte   `protobuf:"bytes,3,opt,name=reply_key,json=replyKey,proto3" json:"reply_key,omitempty"`
+	Signature            []byte   `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *OwnerAuth) Reset()         { *m = OwnerAuth{} }
+func (m *OwnerAuth) String() string { return proto.CompactTextString(m) }
+func (*OwnerAuth) ProtoMessage()    {}
+func (*OwnerAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{3}
+}
+func (m *OwnerAuth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OwnerAuth.Unmarshal(m, b)
+}
+func (m *OwnerAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OwnerAuth.Marshal(b, m, deterministic)
+}
+func (m *OwnerAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnerAuth.Merge(m, src)
+}
+func (m *OwnerAuth) XXX_Size() int {
+	return xxx_messageInfo_OwnerAuth.Size(m)
+}
+func (m *OwnerAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnerAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnerAuth proto.InternalMessageInfo
+
+func (m *OwnerAuth) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *OwnerAuth) GetExpiry() uint64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *OwnerAuth) GetReplyKey() []byte {
+	if m != nil {
+		return m.ReplyKey
+	}
+	return nil
+}
+
+func (m *OwnerAuth) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type PendingNonceRequest struct {
+	Address              []byte     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Auth                 *OwnerAuth `protobuf:"bytes,2,opt,name=auth,proto3" json:"auth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
 func (m *PendingNonceRequest) Reset()         { *m = PendingNonceRequest{} }
 func (m *PendingNonceRequest) String() string { return proto.CompactTextString(m) }
 func (*PendingNonceRequest) ProtoMessage()    {}
 func (*PendingNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{3}
+	return fileDescriptor_8ec141b309055405, []int{4}
 }
 func (m *PendingNonceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingNonceRequest.Unmarshal(m, b)
@@ -174,8 +531,17 @@ func (m *PendingNonceRequest) GetAddress() []byte {
 	return nil
 }
 
+func (m *PendingNonceRequest) GetAuth() *OwnerAuth {
+	if m != nil {
+		return m.Auth
+	}
+	return nil
+}
+
 type PendingNonceResponse struct {
-	Nonce                uint64   `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// The response with nonce encrypted to the reply key, nonce is left empty.
+	Crypted              []byte   `protobuf:"bytes,2,opt,name=crypted,proto3" json:"crypted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -185,7 +551,7 @@ func (m *PendingNonceResponse) Reset()         { *m = PendingNonceResponse{} }
 func (m *PendingNonceResponse) String() string { return proto.CompactTextString(m) }
 func (*PendingNonceResponse) ProtoMessage()    {}
 func (*PendingNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{4}
+	return fileDescriptor_8ec141b309055405, []int{5}
 }
 func (m *PendingNonceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingNonceResponse.Unmarshal(m, b)
@@ -212,9 +578,18 @@ func (m *PendingNonceResponse) GetNonce() uint64 {
 	return 0
 }
 
+func (m *PendingNonceResponse) GetCrypted() []byte {
+	if m != nil {
+		return m.Crypted
+	}
+	return nil
+}
+
 type PoolStatResponse struct {
 	Pending              uint64   `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
 	Queue                uint64   `protobuf:"varint,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	Accounts             uint64   `protobuf:"varint,3,opt,name=accounts,proto3" json:"accounts,omitempty"`
+	Granularity          uint64   `protobuf:"varint,4,opt,name=granularity,proto3" json:"granularity,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -224,7 +599,7 @@ func (m *PoolStatResponse) Reset()         { *m = PoolStatResponse{} }
 func (m *PoolStatResponse) String() string { return proto.CompactTextString(m) }
 func (*PoolStatResponse) ProtoMessage()    {}
 func (*PoolStatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{5}
+	return fileDescriptor_8ec141b309055405, []int{6}
 }
 func (m *PoolStatResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PoolStatResponse.Unmarshal(m, b)
@@ -258,18 +633,33 @@ func (m *PoolStatResponse) GetQueue() uint64 {
 	return 0
 }
 
+func (m *PoolStatResponse) GetAccounts() uint64 {
+	if m != nil {
+		return m.Accounts
+	}
+	return 0
+}
+
+func (m *PoolStatResponse) GetGranularity() uint64 {
+	if m != nil {
+		return m.Granularity
+	}
+	return 0
+}
+
 type PoolContentRequest struct {
-	Address              []byte   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Address              []byte     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Auth                 *OwnerAuth `protobuf:"bytes,2,opt,name=auth,proto3" json:"auth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *PoolContentRequest) Reset()         { *m = PoolContentRequest{} }
 func (m *PoolContentRequest) String() string { return proto.CompactTextString(m) }
 func (*PoolContentRequest) ProtoMessage()    {}
 func (*PoolContentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{6}
+	return fileDescriptor_8ec141b309055405, []int{7}
 }
 func (m *PoolContentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PoolContentRequest.Unmarshal(m, b)
@@ -296,6 +686,13 @@ func (m *PoolContentRequest) GetAddress() []byte {
 	return nil
 }
 
+func (m *PoolContentRequest) GetAuth() *OwnerAuth {
+	if m != nil {
+		return m.Auth
+	}
+	return nil
+}
+
 type TransactionList struct {
 	Txs                  [][]byte `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -307,7 +704,7 @@ func (m *TransactionList) Reset()         { *m = TransactionList{} }
 func (m *TransactionList) String() string { return proto.CompactTextString(m) }
 func (*TransactionList) ProtoMessage()    {}
 func (*TransactionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{7}
+	return fileDescriptor_8ec141b309055405, []int{8}
 }
 func (m *TransactionList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransactionList.Unmarshal(m, b)
@@ -346,7 +743,7 @@ func (m *AccountTransactionList) Reset()         { *m = AccountTransactionList{}
 func (m *AccountTransactionList) String() string { return proto.CompactTextString(m) }
 func (*AccountTransactionList) ProtoMessage()    {}
 func (*AccountTransactionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{8}
+	return fileDescriptor_8ec141b309055405, []int{9}
 }
 func (m *AccountTransactionList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountTransactionList.Unmarshal(m, b)
@@ -381,18 +778,21 @@ func (m *AccountTransactionList) GetTxList() *TransactionList {
 }
 
 type PoolContentResponse struct {
-	PendingList          []*AccountTransactionList `protobuf:"bytes,1,rep,name=pending_list,json=pendingList,proto3" json:"pending_list,omitempty"`
-	QueueList            []*AccountTransactionList `protobuf:"bytes,2,rep,name=queue_list,json=queueList,proto3" json:"queue_list,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	PendingList []*AccountTransactionList `protobuf:"bytes,1,rep,name=pending_list,json=pendingList,proto3" json:"pending_list,omitempty"`
+	QueueList   []*AccountTransactionList `protobuf:"bytes,2,rep,name=queue_list,json=queueList,proto3" json:"queue_list,omitempty"`
+	// The response encrypted to the reply key by PoolContentFrom, the lists
+	// are left empty.
+	Crypted              []byte   `protobuf:"bytes,3,opt,name=crypted,proto3" json:"crypted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PoolContentResponse) Reset()         { *m = PoolContentResponse{} }
 func (m *PoolContentResponse) String() string { return proto.CompactTextString(m) }
 func (*PoolContentResponse) ProtoMessage()    {}
 func (*PoolContentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{9}
+	return fileDescriptor_8ec141b309055405, []int{10}
 }
 func (m *PoolContentResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PoolContentResponse.Unmarshal(m, b)
@@ -426,6 +826,13 @@ func (m *PoolContentResponse) GetQueueList() []*AccountTransactionList {
 	return nil
 }
 
+func (m *PoolContentResponse) GetCrypted() []byte {
+	if m != nil {
+		return m.Crypted
+	}
+	return nil
+}
+
 type PoolPendingResponse struct {
 	PendingList          []*AccountTransactionList `protobuf:"bytes,1,rep,name=pending_list,json=pendingList,proto3" json:"pending_list,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
@@ -437,7 +844,7 @@ func (m *PoolPendingResponse) Reset()         { *m = PoolPendingResponse{} }
 func (m *PoolPendingResponse) String() string { return proto.CompactTextString(m) }
 func (*PoolPendingResponse) ProtoMessage()    {}
 func (*PoolPendingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{10}
+	return fileDescriptor_8ec141b309055405, []int{11}
 }
 func (m *PoolPendingResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PoolPendingResponse.Unmarshal(m, b)
@@ -475,7 +882,7 @@ func (m *PoolLocalsResponse) Reset()         { *m = PoolLocalsResponse{} }
 func (m *PoolLocalsResponse) String() string { return proto.CompactTextString(m) }
 func (*PoolLocalsResponse) ProtoMessage()    {}
 func (*PoolLocalsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{11}
+	return fileDescriptor_8ec141b309055405, []int{12}
 }
 func (m *PoolLocalsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PoolLocalsResponse.Unmarshal(m, b)
@@ -502,133 +909,382 @@ func (m *PoolLocalsResponse) GetAddressList() [][]byte {
 	return nil
 }
 
-type AddTxsRequest struct {
-	TxList               *TransactionList `protobuf:"bytes,1,opt,name=tx_list,json=txList,proto3" json:"tx_list,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+// PoolQueryRequest selects pool transactions, ordered by sender and nonce.
+type PoolQueryRequest struct {
+	State                TxState  `protobuf:"varint,1,opt,name=state,proto3,enum=trusted.v1.TxState" json:"state,omitempty"`
+	Origin               TxOrigin `protobuf:"varint,2,opt,name=origin,proto3,enum=trusted.v1.TxOrigin" json:"origin,omitempty"`
+	MinTip               []byte   `protobuf:"bytes,3,opt,name=min_tip,json=minTip,proto3" json:"min_tip,omitempty"`
+	PageSize             uint32   `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            []byte   `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddTxsRequest) Reset()         { *m = AddTxsRequest{} }
-func (m *AddTxsRequest) String() string { return proto.CompactTextString(m) }
-func (*AddTxsRequest) ProtoMessage()    {}
-func (*AddTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{12}
+func (m *PoolQueryRequest) Reset()         { *m = PoolQueryRequest{} }
+func (m *PoolQueryRequest) String() string { return proto.CompactTextString(m) }
+func (*PoolQueryRequest) ProtoMessage()    {}
+func (*PoolQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{13}
 }
-func (m *AddTxsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddTxsRequest.Unmarshal(m, b)
+func (m *PoolQueryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PoolQueryRequest.Unmarshal(m, b)
 }
-func (m *AddTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddTxsRequest.Marshal(b, m, deterministic)
+func (m *PoolQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PoolQueryRequest.Marshal(b, m, deterministic)
 }
-func (m *AddTxsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddTxsRequest.Merge(m, src)
+func (m *PoolQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolQueryRequest.Merge(m, src)
 }
-func (m *AddTxsRequest) XXX_Size() int {
-	return xxx_messageInfo_AddTxsRequest.Size(m)
+func (m *PoolQueryRequest) XXX_Size() int {
+	return xxx_messageInfo_PoolQueryRequest.Size(m)
 }
-func (m *AddTxsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddTxsRequest.DiscardUnknown(m)
+func (m *PoolQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolQueryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddTxsRequest proto.InternalMessageInfo
+var xxx_messageInfo_PoolQueryRequest proto.InternalMessageInfo
 
-func (m *AddTxsRequest) GetTxList() *TransactionList {
+func (m *PoolQueryRequest) GetState() TxState {
 	if m != nil {
-		return m.TxList
+		return m.State
 	}
-	return nil
+	return TxState_TX_STATE_ANY
 }
 
-type AddTxsResponse struct {
-	Errors               []string `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PoolQueryRequest) GetOrigin() TxOrigin {
+	if m != nil {
+		return m.Origin
+	}
+	return TxOrigin_TX_ORIGIN_ANY
 }
 
-func (m *AddTxsResponse) Reset()         { *m = AddTxsResponse{} }
-func (m *AddTxsResponse) String() string { return proto.CompactTextString(m) }
-func (*AddTxsResponse) ProtoMessage()    {}
-func (*AddTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{13}
-}
-func (m *AddTxsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddTxsResponse.Unmarshal(m, b)
-}
-func (m *AddTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddTxsResponse.Marshal(b, m, deterministic)
-}
-func (m *AddTxsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddTxsResponse.Merge(m, src)
-}
-func (m *AddTxsResponse) XXX_Size() int {
-	return xxx_messageInfo_AddTxsResponse.Size(m)
-}
-func (m *AddTxsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddTxsResponse.DiscardUnknown(m)
+func (m *PoolQueryRequest) GetMinTip() []byte {
+	if m != nil {
+		return m.MinTip
+	}
+	return nil
 }
 
-var xxx_messageInfo_AddTxsResponse proto.InternalMessageInfo
+func (m *PoolQueryRequest) GetPageSize() uint32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
 
-func (m *AddTxsResponse) GetErrors() []string {
+func (m *PoolQueryRequest) GetPageToken() []byte {
 	if m != nil {
-		return m.Errors
+		return m.PageToken
 	}
 	return nil
 }
 
-type TxStatusRequest struct {
-	TxHashs              [][]byte `protobuf:"bytes,1,rep,name=tx_hashs,json=txHashs,proto3" json:"tx_hashs,omitempty"`
+type PoolTransaction struct {
+	Sender               []byte   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Tx                   []byte   `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	Pending              bool     `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	Local                bool     `protobuf:"varint,4,opt,name=local,proto3" json:"local,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TxStatusRequest) Reset()         { *m = TxStatusRequest{} }
-func (m *TxStatusRequest) String() string { return proto.CompactTextString(m) }
-func (*TxStatusRequest) ProtoMessage()    {}
-func (*TxStatusRequest) Descriptor() ([]byte, []int) {
+func (m *PoolTransaction) Reset()         { *m = PoolTransaction{} }
+func (m *PoolTransaction) String() string { return proto.CompactTextString(m) }
+func (*PoolTransaction) ProtoMessage()    {}
+func (*PoolTransaction) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8ec141b309055405, []int{14}
 }
-func (m *TxStatusRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TxStatusRequest.Unmarshal(m, b)
+func (m *PoolTransaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PoolTransaction.Unmarshal(m, b)
 }
-func (m *TxStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TxStatusRequest.Marshal(b, m, deterministic)
+func (m *PoolTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PoolTransaction.Marshal(b, m, deterministic)
 }
-func (m *TxStatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxStatusRequest.Merge(m, src)
+func (m *PoolTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolTransaction.Merge(m, src)
 }
-func (m *TxStatusRequest) XXX_Size() int {
-	return xxx_messageInfo_TxStatusRequest.Size(m)
+func (m *PoolTransaction) XXX_Size() int {
+	return xxx_messageInfo_PoolTransaction.Size(m)
 }
-func (m *TxStatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TxStatusRequest.DiscardUnknown(m)
+func (m *PoolTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolTransaction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TxStatusRequest proto.InternalMessageInfo
+var xxx_messageInfo_PoolTransaction proto.InternalMessageInfo
 
-func (m *TxStatusRequest) GetTxHashs() [][]byte {
+func (m *PoolTransaction) GetSender() []byte {
 	if m != nil {
-		return m.TxHashs
+		return m.Sender
 	}
 	return nil
 }
 
-type TxStatusResponse struct {
-	TxStatus             []uint32 `protobuf:"varint,1,rep,packed,name=tx_status,json=txStatus,proto3" json:"tx_status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PoolTransaction) GetTx() []byte {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
 }
 
-func (m *TxStatusResponse) Reset()         { *m = TxStatusResponse{} }
-func (m *TxStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*TxStatusResponse) ProtoMessage()    {}
-func (*TxStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{15}
-}
+func (m *PoolTransaction) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+func (m *PoolTransaction) GetLocal() bool {
+	if m != nil {
+		return m.Local
+	}
+	return false
+}
+
+type PoolQueryResponse struct {
+	Txs                  []*PoolTransaction `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	NextPageToken        []byte             `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *PoolQueryResponse) Reset()         { *m = PoolQueryResponse{} }
+func (m *PoolQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*PoolQueryResponse) ProtoMessage()    {}
+func (*PoolQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{15}
+}
+func (m *PoolQueryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PoolQueryResponse.Unmarshal(m, b)
+}
+func (m *PoolQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PoolQueryResponse.Marshal(b, m, deterministic)
+}
+func (m *PoolQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolQueryResponse.Merge(m, src)
+}
+func (m *PoolQueryResponse) XXX_Size() int {
+	return xxx_messageInfo_PoolQueryResponse.Size(m)
+}
+func (m *PoolQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolQueryResponse proto.InternalMessageInfo
+
+func (m *PoolQueryResponse) GetTxs() []*PoolTransaction {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *PoolQueryResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
+type AddTxsRequest struct {
+	TxList               *TransactionList `protobuf:"bytes,1,opt,name=tx_list,json=txList,proto3" json:"tx_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *AddTxsRequest) Reset()         { *m = AddTxsRequest{} }
+func (m *AddTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*AddTxsRequest) ProtoMessage()    {}
+func (*AddTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{16}
+}
+func (m *AddTxsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddTxsRequest.Unmarshal(m, b)
+}
+func (m *AddTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddTxsRequest.Marshal(b, m, deterministic)
+}
+func (m *AddTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddTxsRequest.Merge(m, src)
+}
+func (m *AddTxsRequest) XXX_Size() int {
+	return xxx_messageInfo_AddTxsRequest.Size(m)
+}
+func (m *AddTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddTxsRequest proto.InternalMessageInfo
+
+func (m *AddTxsRequest) GetTxList() *TransactionList {
+	if m != nil {
+		return m.TxList
+	}
+	return nil
+}
+
+// TxError is the result of adding a transaction.
+type TxError struct {
+	Code                 TxErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=trusted.v1.TxErrorCode" json:"code,omitempty"`
+	Message              string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *TxError) Reset()         { *m = TxError{} }
+func (m *TxError) String() string { return proto.CompactTextString(m) }
+func (*TxError) ProtoMessage()    {}
+func (*TxError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{17}
+}
+func (m *TxError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxError.Unmarshal(m, b)
+}
+func (m *TxError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxError.Marshal(b, m, deterministic)
+}
+func (m *TxError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxError.Merge(m, src)
+}
+func (m *TxError) XXX_Size() int {
+	return xxx_messageInfo_TxError.Size(m)
+}
+func (m *TxError) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxError proto.InternalMessageInfo
+
+func (m *TxError) GetCode() TxErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return TxErrorCode_TX_ERROR_NONE
+}
+
+func (m *TxError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// AddTxsResponse has a result for each transaction of the request. If none was
+// added the call fails instead, with an ErrorInfo detail for each transaction.
+type AddTxsResponse struct {
+	Errors               []*TxError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *AddTxsResponse) Reset()         { *m = AddTxsResponse{} }
+func (m *AddTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*AddTxsResponse) ProtoMessage()    {}
+func (*AddTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{18}
+}
+func (m *AddTxsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddTxsResponse.Unmarshal(m, b)
+}
+func (m *AddTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddTxsResponse.Marshal(b, m, deterministic)
+}
+func (m *AddTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddTxsResponse.Merge(m, src)
+}
+func (m *AddTxsResponse) XXX_Size() int {
+	return xxx_messageInfo_AddTxsResponse.Size(m)
+}
+func (m *AddTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddTxsResponse proto.InternalMessageInfo
+
+func (m *AddTxsResponse) GetErrors() []*TxError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type TxStatusRequest struct {
+	TxHashs [][]byte `protobuf:"bytes,1,rep,name=tx_hashs,json=txHashs,proto3" json:"tx_hashs,omitempty"`
+	// The pool states of the transactions of owner are only shown to queries
+	// signed by it, the others are unknown.
+	Owner                []byte     `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Auth                 *OwnerAuth `protobuf:"bytes,3,opt,name=auth,proto3" json:"auth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *TxStatusRequest) Reset()         { *m = TxStatusRequest{} }
+func (m *TxStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*TxStatusRequest) ProtoMessage()    {}
+func (*TxStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{19}
+}
+func (m *TxStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxStatusRequest.Unmarshal(m, b)
+}
+func (m *TxStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *TxStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxStatusRequest.Merge(m, src)
+}
+func (m *TxStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_TxStatusRequest.Size(m)
+}
+func (m *TxStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxStatusRequest proto.InternalMessageInfo
+
+func (m *TxStatusRequest) GetTxHashs() [][]byte {
+	if m != nil {
+		return m.TxHashs
+	}
+	return nil
+}
+
+func (m *TxStatusRequest) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *TxStatusRequest) GetAuth() *OwnerAuth {
+	if m != nil {
+		return m.Auth
+	}
+	return nil
+}
+
+type TxStatusResponse struct {
+	TxStatus []uint32          `protobuf:"varint,1,rep,packed,name=tx_status,json=txStatus,proto3" json:"tx_status,omitempty"`
+	Details  []*TxStatusDetail `protobuf:"bytes,2,rep,name=details,proto3" json:"details,omitempty"`
+	// With auth the response encrypted to the reply key, the rest is left empty.
+	Crypted              []byte   `protobuf:"bytes,3,opt,name=crypted,proto3" json:"crypted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxStatusResponse) Reset()         { *m = TxStatusResponse{} }
+func (m *TxStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*TxStatusResponse) ProtoMessage()    {}
+func (*TxStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{20}
+}
 func (m *TxStatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxStatusResponse.Unmarshal(m, b)
 }
@@ -654,18 +1310,89 @@ func (m *TxStatusResponse) GetTxStatus() []uint32 {
 	return nil
 }
 
+func (m *TxStatusResponse) GetDetails() []*TxStatusDetail {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *TxStatusResponse) GetCrypted() []byte {
+	if m != nil {
+		return m.Crypted
+	}
+	return nil
+}
+
+// TxStatusDetail tells which block included a transaction, or why it was
+// dropped. Included and dropped transactions are remembered for a while.
+type TxStatusDetail struct {
+	BlockHash            []byte       `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockNumber          uint64       `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Reason               TxDropReason `protobuf:"varint,3,opt,name=reason,proto3,enum=trusted.v1.TxDropReason" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TxStatusDetail) Reset()         { *m = TxStatusDetail{} }
+func (m *TxStatusDetail) String() string { return proto.CompactTextString(m) }
+func (*TxStatusDetail) ProtoMessage()    {}
+func (*TxStatusDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{21}
+}
+func (m *TxStatusDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxStatusDetail.Unmarshal(m, b)
+}
+func (m *TxStatusDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxStatusDetail.Marshal(b, m, deterministic)
+}
+func (m *TxStatusDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxStatusDetail.Merge(m, src)
+}
+func (m *TxStatusDetail) XXX_Size() int {
+	return xxx_messageInfo_TxStatusDetail.Size(m)
+}
+func (m *TxStatusDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxStatusDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxStatusDetail proto.InternalMessageInfo
+
+func (m *TxStatusDetail) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *TxStatusDetail) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *TxStatusDetail) GetReason() TxDropReason {
+	if m != nil {
+		return m.Reason
+	}
+	return TxDropReason_TX_DROP_NONE
+}
+
 type TxGetRequest struct {
-	TxHash               []byte   `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	TxHash               []byte     `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Auth                 *OwnerAuth `protobuf:"bytes,2,opt,name=auth,proto3" json:"auth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *TxGetRequest) Reset()         { *m = TxGetRequest{} }
 func (m *TxGetRequest) String() string { return proto.CompactTextString(m) }
 func (*TxGetRequest) ProtoMessage()    {}
 func (*TxGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{16}
+	return fileDescriptor_8ec141b309055405, []int{22}
 }
 func (m *TxGetRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxGetRequest.Unmarshal(m, b)
@@ -692,8 +1419,17 @@ func (m *TxGetRequest) GetTxHash() []byte {
 	return nil
 }
 
+func (m *TxGetRequest) GetAuth() *OwnerAuth {
+	if m != nil {
+		return m.Auth
+	}
+	return nil
+}
+
 type TxGetResponse struct {
-	Tx                   []byte   `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	Tx []byte `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// The response with tx encrypted to the reply key, tx is left empty.
+	Crypted              []byte   `protobuf:"bytes,2,opt,name=crypted,proto3" json:"crypted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -703,7 +1439,7 @@ func (m *TxGetResponse) Reset()         { *m = TxGetResponse{} }
 func (m *TxGetResponse) String() string { return proto.CompactTextString(m) }
 func (*TxGetResponse) ProtoMessage()    {}
 func (*TxGetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{17}
+	return fileDescriptor_8ec141b309055405, []int{23}
 }
 func (m *TxGetResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxGetResponse.Unmarshal(m, b)
@@ -730,6 +1466,13 @@ func (m *TxGetResponse) GetTx() []byte {
 	return nil
 }
 
+func (m *TxGetResponse) GetCrypted() []byte {
+	if m != nil {
+		return m.Crypted
+	}
+	return nil
+}
+
 type TxHasRequest struct {
 	TxHash               []byte   `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -741,7 +1484,7 @@ func (m *TxHasRequest) Reset()         { *m = TxHasRequest{} }
 func (m *TxHasRequest) String() string { return proto.CompactTextString(m) }
 func (*TxHasRequest) ProtoMessage()    {}
 func (*TxHasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{18}
+	return fileDescriptor_8ec141b309055405, []int{24}
 }
 func (m *TxHasRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxHasRequest.Unmarshal(m, b)
@@ -779,7 +1522,7 @@ func (m *TxHasResponse) Reset()         { *m = TxHasResponse{} }
 func (m *TxHasResponse) String() string { return proto.CompactTextString(m) }
 func (*TxHasResponse) ProtoMessage()    {}
 func (*TxHasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{19}
+	return fileDescriptor_8ec141b309055405, []int{25}
 }
 func (m *TxHasResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxHasResponse.Unmarshal(m, b)
@@ -818,7 +1561,7 @@ func (m *BlockRequest) Reset()         { *m = BlockRequest{} }
 func (m *BlockRequest) String() string { return proto.CompactTextString(m) }
 func (*BlockRequest) ProtoMessage()    {}
 func (*BlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{20}
+	return fileDescriptor_8ec141b309055405, []int{26}
 }
 func (m *BlockRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockRequest.Unmarshal(m, b)
@@ -863,7 +1606,7 @@ func (m *BlockResponse) Reset()         { *m = BlockResponse{} }
 func (m *BlockResponse) String() string { return proto.CompactTextString(m) }
 func (*BlockResponse) ProtoMessage()    {}
 func (*BlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{21}
+	return fileDescriptor_8ec141b309055405, []int{27}
 }
 func (m *BlockResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockResponse.Unmarshal(m, b)
@@ -890,21 +1633,113 @@ func (m *BlockResponse) GetBlockData() []byte {
 	return nil
 }
 
-type BalanceRequest struct {
-	Address              []byte   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	BlockNum             []byte   `protobuf:"bytes,2,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+type BlockRangeRequest struct {
+	BlockHash            []byte   `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockNum             uint64   `protobuf:"varint,2,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	Count                uint64   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BalanceRequest) Reset()         { *m = BalanceRequest{} }
-func (m *BalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*BalanceRequest) ProtoMessage()    {}
-func (*BalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{22}
+func (m *BlockRangeRequest) Reset()         { *m = BlockRangeRequest{} }
+func (m *BlockRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockRangeRequest) ProtoMessage()    {}
+func (*BlockRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{28}
 }
-func (m *BalanceRequest) XXX_Unmarshal(b []byte) error {
+func (m *BlockRangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockRangeRequest.Unmarshal(m, b)
+}
+func (m *BlockRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockRangeRequest.Marshal(b, m, deterministic)
+}
+func (m *BlockRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRangeRequest.Merge(m, src)
+}
+func (m *BlockRangeRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockRangeRequest.Size(m)
+}
+func (m *BlockRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockRangeRequest proto.InternalMessageInfo
+
+func (m *BlockRangeRequest) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *BlockRangeRequest) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *BlockRangeRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type BlocksResponse struct {
+	BlockData            [][]byte `protobuf:"bytes,1,rep,name=block_data,json=blockData,proto3" json:"block_data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlocksResponse) Reset()         { *m = BlocksResponse{} }
+func (m *BlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*BlocksResponse) ProtoMessage()    {}
+func (*BlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{29}
+}
+func (m *BlocksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlocksResponse.Unmarshal(m, b)
+}
+func (m *BlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlocksResponse.Marshal(b, m, deterministic)
+}
+func (m *BlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlocksResponse.Merge(m, src)
+}
+func (m *BlocksResponse) XXX_Size() int {
+	return xxx_messageInfo_BlocksResponse.Size(m)
+}
+func (m *BlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlocksResponse proto.InternalMessageInfo
+
+func (m *BlocksResponse) GetBlockData() [][]byte {
+	if m != nil {
+		return m.BlockData
+	}
+	return nil
+}
+
+type BalanceRequest struct {
+	Address              []byte   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	BlockNum             []byte   `protobuf:"bytes,2,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BalanceRequest) Reset()         { *m = BalanceRequest{} }
+func (m *BalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*BalanceRequest) ProtoMessage()    {}
+func (*BalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{30}
+}
+func (m *BalanceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BalanceRequest.Unmarshal(m, b)
 }
 func (m *BalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
@@ -947,7 +1782,7 @@ func (m *BalanceResponse) Reset()         { *m = BalanceResponse{} }
 func (m *BalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*BalanceResponse) ProtoMessage()    {}
 func (*BalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{23}
+	return fileDescriptor_8ec141b309055405, []int{31}
 }
 func (m *BalanceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BalanceResponse.Unmarshal(m, b)
@@ -986,7 +1821,7 @@ func (m *NonceRequest) Reset()         { *m = NonceRequest{} }
 func (m *NonceRequest) String() string { return proto.CompactTextString(m) }
 func (*NonceRequest) ProtoMessage()    {}
 func (*NonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{24}
+	return fileDescriptor_8ec141b309055405, []int{32}
 }
 func (m *NonceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NonceRequest.Unmarshal(m, b)
@@ -1031,7 +1866,7 @@ func (m *NonceResponse) Reset()         { *m = NonceResponse{} }
 func (m *NonceResponse) String() string { return proto.CompactTextString(m) }
 func (*NonceResponse) ProtoMessage()    {}
 func (*NonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{25}
+	return fileDescriptor_8ec141b309055405, []int{33}
 }
 func (m *NonceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NonceResponse.Unmarshal(m, b)
@@ -1058,6 +1893,91 @@ func (m *NonceResponse) GetNonce() uint64 {
 	return 0
 }
 
+type HeaderRequest struct {
+	BlockHash            []byte   `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockNum             uint64   `protobuf:"varint,2,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HeaderRequest) Reset()         { *m = HeaderRequest{} }
+func (m *HeaderRequest) String() string { return proto.CompactTextString(m) }
+func (*HeaderRequest) ProtoMessage()    {}
+func (*HeaderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{34}
+}
+func (m *HeaderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeaderRequest.Unmarshal(m, b)
+}
+func (m *HeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeaderRequest.Marshal(b, m, deterministic)
+}
+func (m *HeaderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeaderRequest.Merge(m, src)
+}
+func (m *HeaderRequest) XXX_Size() int {
+	return xxx_messageInfo_HeaderRequest.Size(m)
+}
+func (m *HeaderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeaderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeaderRequest proto.InternalMessageInfo
+
+func (m *HeaderRequest) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *HeaderRequest) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+type HeaderResponse struct {
+	// rlp encoded header, empty if the header is unknown
+	HeaderData           []byte   `protobuf:"bytes,1,opt,name=header_data,json=headerData,proto3" json:"header_data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HeaderResponse) Reset()         { *m = HeaderResponse{} }
+func (m *HeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*HeaderResponse) ProtoMessage()    {}
+func (*HeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{35}
+}
+func (m *HeaderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeaderResponse.Unmarshal(m, b)
+}
+func (m *HeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeaderResponse.Marshal(b, m, deterministic)
+}
+func (m *HeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeaderResponse.Merge(m, src)
+}
+func (m *HeaderResponse) XXX_Size() int {
+	return xxx_messageInfo_HeaderResponse.Size(m)
+}
+func (m *HeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeaderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeaderResponse proto.InternalMessageInfo
+
+func (m *HeaderResponse) GetHeaderData() []byte {
+	if m != nil {
+		return m.HeaderData
+	}
+	return nil
+}
+
 type LatestHeaderRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1068,7 +1988,7 @@ func (m *LatestHeaderRequest) Reset()         { *m = LatestHeaderRequest{} }
 func (m *LatestHeaderRequest) String() string { return proto.CompactTextString(m) }
 func (*LatestHeaderRequest) ProtoMessage()    {}
 func (*LatestHeaderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{26}
+	return fileDescriptor_8ec141b309055405, []int{36}
 }
 func (m *LatestHeaderRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LatestHeaderRequest.Unmarshal(m, b)
@@ -1100,7 +2020,7 @@ func (m *LatestHeaderResponse) Reset()         { *m = LatestHeaderResponse{} }
 func (m *LatestHeaderResponse) String() string { return proto.CompactTextString(m) }
 func (*LatestHeaderResponse) ProtoMessage()    {}
 func (*LatestHeaderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{27}
+	return fileDescriptor_8ec141b309055405, []int{37}
 }
 func (m *LatestHeaderResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LatestHeaderResponse.Unmarshal(m, b)
@@ -1144,7 +2064,7 @@ func (m *CurrentBlockRequest) Reset()         { *m = CurrentBlockRequest{} }
 func (m *CurrentBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*CurrentBlockRequest) ProtoMessage()    {}
 func (*CurrentBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{28}
+	return fileDescriptor_8ec141b309055405, []int{38}
 }
 func (m *CurrentBlockRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CurrentBlockRequest.Unmarshal(m, b)
@@ -1175,7 +2095,7 @@ func (m *CurrentBlockResponse) Reset()         { *m = CurrentBlockResponse{} }
 func (m *CurrentBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*CurrentBlockResponse) ProtoMessage()    {}
 func (*CurrentBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{29}
+	return fileDescriptor_8ec141b309055405, []int{39}
 }
 func (m *CurrentBlockResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CurrentBlockResponse.Unmarshal(m, b)
@@ -1212,7 +2132,7 @@ func (m *ChainHeadEventRequest) Reset()         { *m = ChainHeadEventRequest{} }
 func (m *ChainHeadEventRequest) String() string { return proto.CompactTextString(m) }
 func (*ChainHeadEventRequest) ProtoMessage()    {}
 func (*ChainHeadEventRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{30}
+	return fileDescriptor_8ec141b309055405, []int{40}
 }
 func (m *ChainHeadEventRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChainHeadEventRequest.Unmarshal(m, b)
@@ -1243,7 +2163,7 @@ func (m *ChainHeadEventResponse) Reset()         { *m = ChainHeadEventResponse{}
 func (m *ChainHeadEventResponse) String() string { return proto.CompactTextString(m) }
 func (*ChainHeadEventResponse) ProtoMessage()    {}
 func (*ChainHeadEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{31}
+	return fileDescriptor_8ec141b309055405, []int{41}
 }
 func (m *ChainHeadEventResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChainHeadEventResponse.Unmarshal(m, b)
@@ -1270,6 +2190,193 @@ func (m *ChainHeadEventResponse) GetBlockData() []byte {
 	return nil
 }
 
+type ChainInfoRequest struct {
+	// protocol version and features of the engine.
+	ProtocolVersion      uint32   `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	Features             []string `protobuf:"bytes,2,rep,name=features,proto3" json:"features,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChainInfoRequest) Reset()         { *m = ChainInfoRequest{} }
+func (m *ChainInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*ChainInfoRequest) ProtoMessage()    {}
+func (*ChainInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{42}
+}
+func (m *ChainInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainInfoRequest.Unmarshal(m, b)
+}
+func (m *ChainInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *ChainInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainInfoRequest.Merge(m, src)
+}
+func (m *ChainInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_ChainInfoRequest.Size(m)
+}
+func (m *ChainInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainInfoRequest proto.InternalMessageInfo
+
+func (m *ChainInfoRequest) GetProtocolVersion() uint32 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
+}
+
+func (m *ChainInfoRequest) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+type ChainInfoResponse struct {
+	ProtocolVersion uint32 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	ChainId         []byte `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	GenesisHash     []byte `protobuf:"bytes,3,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+	// json encoded fork activation config (params.ChainConfig).
+	ChainConfig          []byte   `protobuf:"bytes,4,opt,name=chain_config,json=chainConfig,proto3" json:"chain_config,omitempty"`
+	Features             []string `protobuf:"bytes,5,rep,name=features,proto3" json:"features,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChainInfoResponse) Reset()         { *m = ChainInfoResponse{} }
+func (m *ChainInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*ChainInfoResponse) ProtoMessage()    {}
+func (*ChainInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{43}
+}
+func (m *ChainInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainInfoResponse.Unmarshal(m, b)
+}
+func (m *ChainInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *ChainInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainInfoResponse.Merge(m, src)
+}
+func (m *ChainInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_ChainInfoResponse.Size(m)
+}
+func (m *ChainInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainInfoResponse proto.InternalMessageInfo
+
+func (m *ChainInfoResponse) GetProtocolVersion() uint32 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
+}
+
+func (m *ChainInfoResponse) GetChainId() []byte {
+	if m != nil {
+		return m.ChainId
+	}
+	return nil
+}
+
+func (m *ChainInfoResponse) GetGenesisHash() []byte {
+	if m != nil {
+		return m.GenesisHash
+	}
+	return nil
+}
+
+func (m *ChainInfoResponse) GetChainConfig() []byte {
+	if m != nil {
+		return m.ChainConfig
+	}
+	return nil
+}
+
+func (m *ChainInfoResponse) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+type ChainHeaderEventRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChainHeaderEventRequest) Reset()         { *m = ChainHeaderEventRequest{} }
+func (m *ChainHeaderEventRequest) String() string { return proto.CompactTextString(m) }
+func (*ChainHeaderEventRequest) ProtoMessage()    {}
+func (*ChainHeaderEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{44}
+}
+func (m *ChainHeaderEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainHeaderEventRequest.Unmarshal(m, b)
+}
+func (m *ChainHeaderEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainHeaderEventRequest.Marshal(b, m, deterministic)
+}
+func (m *ChainHeaderEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainHeaderEventRequest.Merge(m, src)
+}
+func (m *ChainHeaderEventRequest) XXX_Size() int {
+	return xxx_messageInfo_ChainHeaderEventRequest.Size(m)
+}
+func (m *ChainHeaderEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainHeaderEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainHeaderEventRequest proto.InternalMessageInfo
+
+type ChainHeaderEventResponse struct {
+	// rlp encoded header
+	HeaderData           []byte   `protobuf:"bytes,1,opt,name=header_data,json=headerData,proto3" json:"header_data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChainHeaderEventResponse) Reset()         { *m = ChainHeaderEventResponse{} }
+func (m *ChainHeaderEventResponse) String() string { return proto.CompactTextString(m) }
+func (*ChainHeaderEventResponse) ProtoMessage()    {}
+func (*ChainHeaderEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{45}
+}
+func (m *ChainHeaderEventResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainHeaderEventResponse.Unmarshal(m, b)
+}
+func (m *ChainHeaderEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainHeaderEventResponse.Marshal(b, m, deterministic)
+}
+func (m *ChainHeaderEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainHeaderEventResponse.Merge(m, src)
+}
+func (m *ChainHeaderEventResponse) XXX_Size() int {
+	return xxx_messageInfo_ChainHeaderEventResponse.Size(m)
+}
+func (m *ChainHeaderEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainHeaderEventResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainHeaderEventResponse proto.InternalMessageInfo
+
+func (m *ChainHeaderEventResponse) GetHeaderData() []byte {
+	if m != nil {
+		return m.HeaderData
+	}
+	return nil
+}
+
 type CryptRequest struct {
 	Method               uint32   `protobuf:"varint,1,opt,name=method,proto3" json:"method,omitempty"`
 	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -1282,7 +2389,7 @@ func (m *CryptRequest) Reset()         { *m = CryptRequest{} }
 func (m *CryptRequest) String() string { return proto.CompactTextString(m) }
 func (*CryptRequest) ProtoMessage()    {}
 func (*CryptRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{32}
+	return fileDescriptor_8ec141b309055405, []int{46}
 }
 func (m *CryptRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CryptRequest.Unmarshal(m, b)
@@ -1327,7 +2434,7 @@ func (m *CryptResponse) Reset()         { *m = CryptResponse{} }
 func (m *CryptResponse) String() string { return proto.CompactTextString(m) }
 func (*CryptResponse) ProtoMessage()    {}
 func (*CryptResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{33}
+	return fileDescriptor_8ec141b309055405, []int{47}
 }
 func (m *CryptResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CryptResponse.Unmarshal(m, b)
@@ -1365,7 +2472,7 @@ func (m *AddTrustedTxsRequest) Reset()         { *m = AddTrustedTxsRequest{} }
 func (m *AddTrustedTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*AddTrustedTxsRequest) ProtoMessage()    {}
 func (*AddTrustedTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{34}
+	return fileDescriptor_8ec141b309055405, []int{48}
 }
 func (m *AddTrustedTxsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddTrustedTxsRequest.Unmarshal(m, b)
@@ -1395,7 +2502,7 @@ func (m *AddTrustedTxsRequest) GetCtyptedTxs() [][]byte {
 type AddTrustedTxResult struct {
 	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	Asset                []byte   `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Error                *TxError `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1405,7 +2512,7 @@ func (m *AddTrustedTxResult) Reset()         { *m = AddTrustedTxResult{} }
 func (m *AddTrustedTxResult) String() string { return proto.CompactTextString(m) }
 func (*AddTrustedTxResult) ProtoMessage()    {}
 func (*AddTrustedTxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{35}
+	return fileDescriptor_8ec141b309055405, []int{49}
 }
 func (m *AddTrustedTxResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddTrustedTxResult.Unmarshal(m, b)
@@ -1439,13 +2546,15 @@ func (m *AddTrustedTxResult) GetAsset() []byte {
 	return nil
 }
 
-func (m *AddTrustedTxResult) GetError() string {
+func (m *AddTrustedTxResult) GetError() *TxError {
 	if m != nil {
 		return m.Error
 	}
-	return ""
+	return nil
 }
 
+// AddTrustedTxsResponse has a result for each transaction of the request, like
+// AddTxsResponse.
 type AddTrustedTxsResponse struct {
 	Results              []*AddTrustedTxResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
@@ -1457,7 +2566,7 @@ func (m *AddTrustedTxsResponse) Reset()         { *m = AddTrustedTxsResponse{} }
 func (m *AddTrustedTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*AddTrustedTxsResponse) ProtoMessage()    {}
 func (*AddTrustedTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{36}
+	return fileDescriptor_8ec141b309055405, []int{50}
 }
 func (m *AddTrustedTxsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddTrustedTxsResponse.Unmarshal(m, b)
@@ -1494,7 +2603,7 @@ func (m *CheckSecretKeyRequest) Reset()         { *m = CheckSecretKeyRequest{} }
 func (m *CheckSecretKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*CheckSecretKeyRequest) ProtoMessage()    {}
 func (*CheckSecretKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{37}
+	return fileDescriptor_8ec141b309055405, []int{51}
 }
 func (m *CheckSecretKeyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CheckSecretKeyRequest.Unmarshal(m, b)
@@ -1525,7 +2634,7 @@ func (m *CheckSecretKeyResponse) Reset()         { *m = CheckSecretKeyResponse{}
 func (m *CheckSecretKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckSecretKeyResponse) ProtoMessage()    {}
 func (*CheckSecretKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{38}
+	return fileDescriptor_8ec141b309055405, []int{52}
 }
 func (m *CheckSecretKeyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CheckSecretKeyResponse.Unmarshal(m, b)
@@ -1563,7 +2672,7 @@ func (m *GetAuthDataRequest) Reset()         { *m = GetAuthDataRequest{} }
 func (m *GetAuthDataRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAuthDataRequest) ProtoMessage()    {}
 func (*GetAuthDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{39}
+	return fileDescriptor_8ec141b309055405, []int{53}
 }
 func (m *GetAuthDataRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAuthDataRequest.Unmarshal(m, b)
@@ -1601,7 +2710,7 @@ func (m *GetAuthDataResponse) Reset()         { *m = GetAuthDataResponse{} }
 func (m *GetAuthDataResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAuthDataResponse) ProtoMessage()    {}
 func (*GetAuthDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{40}
+	return fileDescriptor_8ec141b309055405, []int{54}
 }
 func (m *GetAuthDataResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAuthDataResponse.Unmarshal(m, b)
@@ -1640,7 +2749,7 @@ func (m *VerifyAuthRequest) Reset()         { *m = VerifyAuthRequest{} }
 func (m *VerifyAuthRequest) String() string { return proto.CompactTextString(m) }
 func (*VerifyAuthRequest) ProtoMessage()    {}
 func (*VerifyAuthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{41}
+	return fileDescriptor_8ec141b309055405, []int{55}
 }
 func (m *VerifyAuthRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyAuthRequest.Unmarshal(m, b)
@@ -1674,8 +2783,8 @@ func (m *VerifyAuthRequest) GetAuthData() []byte {
 	return nil
 }
 
+// VerifyAuthResponse is empty, a failed verification fails the call.
 type VerifyAuthResponse struct {
-	Error                string   `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1685,7 +2794,7 @@ func (m *VerifyAuthResponse) Reset()         { *m = VerifyAuthResponse{} }
 func (m *VerifyAuthResponse) String() string { return proto.CompactTextString(m) }
 func (*VerifyAuthResponse) ProtoMessage()    {}
 func (*VerifyAuthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{42}
+	return fileDescriptor_8ec141b309055405, []int{56}
 }
 func (m *VerifyAuthResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyAuthResponse.Unmarshal(m, b)
@@ -1705,13 +2814,6 @@ func (m *VerifyAuthResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VerifyAuthResponse proto.InternalMessageInfo
 
-func (m *VerifyAuthResponse) GetError() string {
-	if m != nil {
-		return m.Error
-	}
-	return ""
-}
-
 type GetVerifyDataRequest struct {
 	PeerId               string   `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1723,7 +2825,7 @@ func (m *GetVerifyDataRequest) Reset()         { *m = GetVerifyDataRequest{} }
 func (m *GetVerifyDataRequest) String() string { return proto.CompactTextString(m) }
 func (*GetVerifyDataRequest) ProtoMessage()    {}
 func (*GetVerifyDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{43}
+	return fileDescriptor_8ec141b309055405, []int{57}
 }
 func (m *GetVerifyDataRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetVerifyDataRequest.Unmarshal(m, b)
@@ -1761,7 +2863,7 @@ func (m *GetVerifyDataResponse) Reset()         { *m = GetVerifyDataResponse{} }
 func (m *GetVerifyDataResponse) String() string { return proto.CompactTextString(m) }
 func (*GetVerifyDataResponse) ProtoMessage()    {}
 func (*GetVerifyDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{44}
+	return fileDescriptor_8ec141b309055405, []int{58}
 }
 func (m *GetVerifyDataResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetVerifyDataResponse.Unmarshal(m, b)
@@ -1800,7 +2902,7 @@ func (m *VerifyRemoteVerifyRequest) Reset()         { *m = VerifyRemoteVerifyReq
 func (m *VerifyRemoteVerifyRequest) String() string { return proto.CompactTextString(m) }
 func (*VerifyRemoteVerifyRequest) ProtoMessage()    {}
 func (*VerifyRemoteVerifyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{45}
+	return fileDescriptor_8ec141b309055405, []int{59}
 }
 func (m *VerifyRemoteVerifyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyRemoteVerifyRequest.Unmarshal(m, b)
@@ -1834,8 +2936,8 @@ func (m *VerifyRemoteVerifyRequest) GetVerifyData() []byte {
 	return nil
 }
 
+// VerifyRemoteVerifyResponse is empty, a failed verification fails the call.
 type VerifyRemoteVerifyResponse struct {
-	Error                string   `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1845,7 +2947,7 @@ func (m *VerifyRemoteVerifyResponse) Reset()         { *m = VerifyRemoteVerifyRe
 func (m *VerifyRemoteVerifyResponse) String() string { return proto.CompactTextString(m) }
 func (*VerifyRemoteVerifyResponse) ProtoMessage()    {}
 func (*VerifyRemoteVerifyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{46}
+	return fileDescriptor_8ec141b309055405, []int{60}
 }
 func (m *VerifyRemoteVerifyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyRemoteVerifyResponse.Unmarshal(m, b)
@@ -1865,13 +2967,6 @@ func (m *VerifyRemoteVerifyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VerifyRemoteVerifyResponse proto.InternalMessageInfo
 
-func (m *VerifyRemoteVerifyResponse) GetError() string {
-	if m != nil {
-		return m.Error
-	}
-	return ""
-}
-
 type GetRequestKeyDataRequest struct {
 	PeerId               string   `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1883,7 +2978,7 @@ func (m *GetRequestKeyDataRequest) Reset()         { *m = GetRequestKeyDataReque
 func (m *GetRequestKeyDataRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRequestKeyDataRequest) ProtoMessage()    {}
 func (*GetRequestKeyDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{47}
+	return fileDescriptor_8ec141b309055405, []int{61}
 }
 func (m *GetRequestKeyDataRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRequestKeyDataRequest.Unmarshal(m, b)
@@ -1921,7 +3016,7 @@ func (m *GetRequestKeyDataResponse) Reset()         { *m = GetRequestKeyDataResp
 func (m *GetRequestKeyDataResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRequestKeyDataResponse) ProtoMessage()    {}
 func (*GetRequestKeyDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{48}
+	return fileDescriptor_8ec141b309055405, []int{62}
 }
 func (m *GetRequestKeyDataResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRequestKeyDataResponse.Unmarshal(m, b)
@@ -1960,7 +3055,7 @@ func (m *VerifyRequestKeyDataRequest) Reset()         { *m = VerifyRequestKeyDat
 func (m *VerifyRequestKeyDataRequest) String() string { return proto.CompactTextString(m) }
 func (*VerifyRequestKeyDataRequest) ProtoMessage()    {}
 func (*VerifyRequestKeyDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{49}
+	return fileDescriptor_8ec141b309055405, []int{63}
 }
 func (m *VerifyRequestKeyDataRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyRequestKeyDataRequest.Unmarshal(m, b)
@@ -1994,8 +3089,8 @@ func (m *VerifyRequestKeyDataRequest) GetRequestKeyData() []byte {
 	return nil
 }
 
+// VerifyRequestKeyDataResponse is empty, a failed verification fails the call.
 type VerifyRequestKeyDataResponse struct {
-	Error                string   `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2005,7 +3100,7 @@ func (m *VerifyRequestKeyDataResponse) Reset()         { *m = VerifyRequestKeyDa
 func (m *VerifyRequestKeyDataResponse) String() string { return proto.CompactTextString(m) }
 func (*VerifyRequestKeyDataResponse) ProtoMessage()    {}
 func (*VerifyRequestKeyDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{50}
+	return fileDescriptor_8ec141b309055405, []int{64}
 }
 func (m *VerifyRequestKeyDataResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyRequestKeyDataResponse.Unmarshal(m, b)
@@ -2025,13 +3120,6 @@ func (m *VerifyRequestKeyDataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VerifyRequestKeyDataResponse proto.InternalMessageInfo
 
-func (m *VerifyRequestKeyDataResponse) GetError() string {
-	if m != nil {
-		return m.Error
-	}
-	return ""
-}
-
 type GetResponseKeyDataRequest struct {
 	PeerId               string   `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2043,7 +3131,7 @@ func (m *GetResponseKeyDataRequest) Reset()         { *m = GetResponseKeyDataReq
 func (m *GetResponseKeyDataRequest) String() string { return proto.CompactTextString(m) }
 func (*GetResponseKeyDataRequest) ProtoMessage()    {}
 func (*GetResponseKeyDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{51}
+	return fileDescriptor_8ec141b309055405, []int{65}
 }
 func (m *GetResponseKeyDataRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetResponseKeyDataRequest.Unmarshal(m, b)
@@ -2081,7 +3169,7 @@ func (m *GetResponseKeyDataResponse) Reset()         { *m = GetResponseKeyDataRe
 func (m *GetResponseKeyDataResponse) String() string { return proto.CompactTextString(m) }
 func (*GetResponseKeyDataResponse) ProtoMessage()    {}
 func (*GetResponseKeyDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{52}
+	return fileDescriptor_8ec141b309055405, []int{66}
 }
 func (m *GetResponseKeyDataResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetResponseKeyDataResponse.Unmarshal(m, b)
@@ -2120,7 +3208,7 @@ func (m *VerifyResponseKeyRequest) Reset()         { *m = VerifyResponseKeyReque
 func (m *VerifyResponseKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*VerifyResponseKeyRequest) ProtoMessage()    {}
 func (*VerifyResponseKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{53}
+	return fileDescriptor_8ec141b309055405, []int{67}
 }
 func (m *VerifyResponseKeyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyResponseKeyRequest.Unmarshal(m, b)
@@ -2154,8 +3242,8 @@ func (m *VerifyResponseKeyRequest) GetResponseKeyData() []byte {
 	return nil
 }
 
+// VerifyResponseKeyResponse is empty, a failed verification fails the call.
 type VerifyResponseKeyResponse struct {
-	Error                string   `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2165,7 +3253,7 @@ func (m *VerifyResponseKeyResponse) Reset()         { *m = VerifyResponseKeyResp
 func (m *VerifyResponseKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*VerifyResponseKeyResponse) ProtoMessage()    {}
 func (*VerifyResponseKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{54}
+	return fileDescriptor_8ec141b309055405, []int{68}
 }
 func (m *VerifyResponseKeyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyResponseKeyResponse.Unmarshal(m, b)
@@ -2185,13 +3273,6 @@ func (m *VerifyResponseKeyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VerifyResponseKeyResponse proto.InternalMessageInfo
 
-func (m *VerifyResponseKeyResponse) GetError() string {
-	if m != nil {
-		return m.Error
-	}
-	return ""
-}
-
 // 1. eth grpc api ForkchoiceUpdatedV1, special param is parentHash and timestamp.
 // 2. eth1.0 worker newWork commit, special param is parentHash and timestamp.
 type FillBlockRequest struct {
@@ -2206,7 +3287,7 @@ func (m *FillBlockRequest) Reset()         { *m = FillBlockRequest{} }
 func (m *FillBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*FillBlockRequest) ProtoMessage()    {}
 func (*FillBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{55}
+	return fileDescriptor_8ec141b309055405, []int{69}
 }
 func (m *FillBlockRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FillBlockRequest.Unmarshal(m, b)
@@ -2251,7 +3332,7 @@ func (m *FillBlockResponse) Reset()         { *m = FillBlockResponse{} }
 func (m *FillBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*FillBlockResponse) ProtoMessage()    {}
 func (*FillBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{56}
+	return fileDescriptor_8ec141b309055405, []int{70}
 }
 func (m *FillBlockResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FillBlockResponse.Unmarshal(m, b)
@@ -2289,7 +3370,7 @@ func (m *CommittedBlockVerifyRequest) Reset()         { *m = CommittedBlockVerif
 func (m *CommittedBlockVerifyRequest) String() string { return proto.CompactTextString(m) }
 func (*CommittedBlockVerifyRequest) ProtoMessage()    {}
 func (*CommittedBlockVerifyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{57}
+	return fileDescriptor_8ec141b309055405, []int{71}
 }
 func (m *CommittedBlockVerifyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CommittedBlockVerifyRequest.Unmarshal(m, b)
@@ -2326,7 +3407,7 @@ func (m *CommittedBlockVerifyResponse) Reset()         { *m = CommittedBlockVeri
 func (m *CommittedBlockVerifyResponse) String() string { return proto.CompactTextString(m) }
 func (*CommittedBlockVerifyResponse) ProtoMessage()    {}
 func (*CommittedBlockVerifyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{58}
+	return fileDescriptor_8ec141b309055405, []int{72}
 }
 func (m *CommittedBlockVerifyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CommittedBlockVerifyResponse.Unmarshal(m, b)
@@ -2346,17 +3427,26 @@ func (m *CommittedBlockVerifyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommittedBlockVerifyResponse proto.InternalMessageInfo
 
+// SubscribeNewTxRequest selects the new transactions to receive and how they
+// are batched. Empty filters select any transaction.
 type SubscribeNewTxRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Senders              [][]byte             `protobuf:"bytes,1,rep,name=senders,proto3" json:"senders,omitempty"`
+	Recipients           [][]byte             `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	TxTypes              []uint32             `protobuf:"varint,3,rep,packed,name=tx_types,json=txTypes,proto3" json:"tx_types,omitempty"`
+	MinTip               []byte               `protobuf:"bytes,4,opt,name=min_tip,json=minTip,proto3" json:"min_tip,omitempty"`
+	MaxBatch             uint32               `protobuf:"varint,5,opt,name=max_batch,json=maxBatch,proto3" json:"max_batch,omitempty"`
+	FlushInterval        *durationpb.Duration `protobuf:"bytes,6,opt,name=flush_interval,json=flushInterval,proto3" json:"flush_interval,omitempty"`
+	ResumeAfter          uint64               `protobuf:"varint,7,opt,name=resume_after,json=resumeAfter,proto3" json:"resume_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *SubscribeNewTxRequest) Reset()         { *m = SubscribeNewTxRequest{} }
 func (m *SubscribeNewTxRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeNewTxRequest) ProtoMessage()    {}
 func (*SubscribeNewTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{59}
+	return fileDescriptor_8ec141b309055405, []int{73}
 }
 func (m *SubscribeNewTxRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubscribeNewTxRequest.Unmarshal(m, b)
@@ -2376,8 +3466,58 @@ func (m *SubscribeNewTxRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SubscribeNewTxRequest proto.InternalMessageInfo
 
+func (m *SubscribeNewTxRequest) GetSenders() [][]byte {
+	if m != nil {
+		return m.Senders
+	}
+	return nil
+}
+
+func (m *SubscribeNewTxRequest) GetRecipients() [][]byte {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *SubscribeNewTxRequest) GetTxTypes() []uint32 {
+	if m != nil {
+		return m.TxTypes
+	}
+	return nil
+}
+
+func (m *SubscribeNewTxRequest) GetMinTip() []byte {
+	if m != nil {
+		return m.MinTip
+	}
+	return nil
+}
+
+func (m *SubscribeNewTxRequest) GetMaxBatch() uint32 {
+	if m != nil {
+		return m.MaxBatch
+	}
+	return 0
+}
+
+func (m *SubscribeNewTxRequest) GetFlushInterval() *durationpb.Duration {
+	if m != nil {
+		return m.FlushInterval
+	}
+	return nil
+}
+
+func (m *SubscribeNewTxRequest) GetResumeAfter() uint64 {
+	if m != nil {
+		return m.ResumeAfter
+	}
+	return 0
+}
+
 type SubscribeNewTxResponse struct {
 	CryptedNewTx         [][]byte `protobuf:"bytes,1,rep,name=crypted_new_tx,json=cryptedNewTx,proto3" json:"crypted_new_tx,omitempty"`
+	Sequence             uint64   `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2387,7 +3527,7 @@ func (m *SubscribeNewTxResponse) Reset()         { *m = SubscribeNewTxResponse{}
 func (m *SubscribeNewTxResponse) String() string { return proto.CompactTextString(m) }
 func (*SubscribeNewTxResponse) ProtoMessage()    {}
 func (*SubscribeNewTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ec141b309055405, []int{60}
+	return fileDescriptor_8ec141b309055405, []int{74}
 }
 func (m *SubscribeNewTxResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubscribeNewTxResponse.Unmarshal(m, b)
@@ -2414,10 +3554,640 @@ func (m *SubscribeNewTxResponse) GetCryptedNewTx() [][]byte {
 	return nil
 }
 
+func (m *SubscribeNewTxResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// SubscribeTxEventsRequest selects the lifecycle events to stream, empty lists
+// select all.
+type SubscribeTxEventsRequest struct {
+	Senders              [][]byte      `protobuf:"bytes,1,rep,name=senders,proto3" json:"senders,omitempty"`
+	Kinds                []TxEventKind `protobuf:"varint,2,rep,packed,name=kinds,proto3,enum=trusted.v1.TxEventKind" json:"kinds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SubscribeTxEventsRequest) Reset()         { *m = SubscribeTxEventsRequest{} }
+func (m *SubscribeTxEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeTxEventsRequest) ProtoMessage()    {}
+func (*SubscribeTxEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{75}
+}
+func (m *SubscribeTxEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeTxEventsRequest.Unmarshal(m, b)
+}
+func (m *SubscribeTxEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeTxEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeTxEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeTxEventsRequest.Merge(m, src)
+}
+func (m *SubscribeTxEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeTxEventsRequest.Size(m)
+}
+func (m *SubscribeTxEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeTxEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeTxEventsRequest proto.InternalMessageInfo
+
+func (m *SubscribeTxEventsRequest) GetSenders() [][]byte {
+	if m != nil {
+		return m.Senders
+	}
+	return nil
+}
+
+func (m *SubscribeTxEventsRequest) GetKinds() []TxEventKind {
+	if m != nil {
+		return m.Kinds
+	}
+	return nil
+}
+
+type TxEvent struct {
+	Kind                 TxEventKind  `protobuf:"varint,1,opt,name=kind,proto3,enum=trusted.v1.TxEventKind" json:"kind,omitempty"`
+	Hash                 []byte       `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Sender               []byte       `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Nonce                uint64       `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ReplacedBy           []byte       `protobuf:"bytes,5,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	Reason               TxDropReason `protobuf:"varint,6,opt,name=reason,proto3,enum=trusted.v1.TxDropReason" json:"reason,omitempty"`
+	BlockHash            []byte       `protobuf:"bytes,7,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockNumber          uint64       `protobuf:"varint,8,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TxEvent) Reset()         { *m = TxEvent{} }
+func (m *TxEvent) String() string { return proto.CompactTextString(m) }
+func (*TxEvent) ProtoMessage()    {}
+func (*TxEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{76}
+}
+func (m *TxEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxEvent.Unmarshal(m, b)
+}
+func (m *TxEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxEvent.Marshal(b, m, deterministic)
+}
+func (m *TxEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxEvent.Merge(m, src)
+}
+func (m *TxEvent) XXX_Size() int {
+	return xxx_messageInfo_TxEvent.Size(m)
+}
+func (m *TxEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxEvent proto.InternalMessageInfo
+
+func (m *TxEvent) GetKind() TxEventKind {
+	if m != nil {
+		return m.Kind
+	}
+	return TxEventKind_TX_EVENT_UNKNOWN
+}
+
+func (m *TxEvent) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *TxEvent) GetSender() []byte {
+	if m != nil {
+		return m.Sender
+	}
+	return nil
+}
+
+func (m *TxEvent) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *TxEvent) GetReplacedBy() []byte {
+	if m != nil {
+		return m.ReplacedBy
+	}
+	return nil
+}
+
+func (m *TxEvent) GetReason() TxDropReason {
+	if m != nil {
+		return m.Reason
+	}
+	return TxDropReason_TX_DROP_NONE
+}
+
+func (m *TxEvent) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *TxEvent) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+// SubscribeTxEventsResponse holds the events of one pool update, in order.
+type SubscribeTxEventsResponse struct {
+	Events               []*TxEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SubscribeTxEventsResponse) Reset()         { *m = SubscribeTxEventsResponse{} }
+func (m *SubscribeTxEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeTxEventsResponse) ProtoMessage()    {}
+func (*SubscribeTxEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{77}
+}
+func (m *SubscribeTxEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeTxEventsResponse.Unmarshal(m, b)
+}
+func (m *SubscribeTxEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeTxEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *SubscribeTxEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeTxEventsResponse.Merge(m, src)
+}
+func (m *SubscribeTxEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_SubscribeTxEventsResponse.Size(m)
+}
+func (m *SubscribeTxEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeTxEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeTxEventsResponse proto.InternalMessageInfo
+
+func (m *SubscribeTxEventsResponse) GetEvents() []*TxEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type WatchTransactionsRequest struct {
+	Hashes  [][]byte             `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	Timeout *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Blocks on top of an included transaction until it is final, 0 makes it
+	// final once included.
+	Confirmations uint32 `protobuf:"varint,3,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	// The pool states of the transactions of owner are only shown to watches
+	// signed by it, the others are unknown.
+	Owner                []byte     `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Auth                 *OwnerAuth `protobuf:"bytes,5,opt,name=auth,proto3" json:"auth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *WatchTransactionsRequest) Reset()         { *m = WatchTransactionsRequest{} }
+func (m *WatchTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchTransactionsRequest) ProtoMessage()    {}
+func (*WatchTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{78}
+}
+func (m *WatchTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchTransactionsRequest.Unmarshal(m, b)
+}
+func (m *WatchTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchTransactionsRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchTransactionsRequest.Merge(m, src)
+}
+func (m *WatchTransactionsRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchTransactionsRequest.Size(m)
+}
+func (m *WatchTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchTransactionsRequest proto.InternalMessageInfo
+
+func (m *WatchTransactionsRequest) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+func (m *WatchTransactionsRequest) GetTimeout() *durationpb.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *WatchTransactionsRequest) GetConfirmations() uint32 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *WatchTransactionsRequest) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *WatchTransactionsRequest) GetAuth() *OwnerAuth {
+	if m != nil {
+		return m.Auth
+	}
+	return nil
+}
+
+type TxWatchEvent struct {
+	Hash                 []byte        `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Status               TxWatchStatus `protobuf:"varint,2,opt,name=status,proto3,enum=trusted.v1.TxWatchStatus" json:"status,omitempty"`
+	Final                bool          `protobuf:"varint,3,opt,name=final,proto3" json:"final,omitempty"`
+	BlockHash            []byte        `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockNumber          uint64        `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Reason               TxDropReason  `protobuf:"varint,6,opt,name=reason,proto3,enum=trusted.v1.TxDropReason" json:"reason,omitempty"`
+	ReplacedBy           []byte        `protobuf:"bytes,7,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TxWatchEvent) Reset()         { *m = TxWatchEvent{} }
+func (m *TxWatchEvent) String() string { return proto.CompactTextString(m) }
+func (*TxWatchEvent) ProtoMessage()    {}
+func (*TxWatchEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{79}
+}
+func (m *TxWatchEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxWatchEvent.Unmarshal(m, b)
+}
+func (m *TxWatchEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxWatchEvent.Marshal(b, m, deterministic)
+}
+func (m *TxWatchEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxWatchEvent.Merge(m, src)
+}
+func (m *TxWatchEvent) XXX_Size() int {
+	return xxx_messageInfo_TxWatchEvent.Size(m)
+}
+func (m *TxWatchEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxWatchEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxWatchEvent proto.InternalMessageInfo
+
+func (m *TxWatchEvent) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *TxWatchEvent) GetStatus() TxWatchStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TxWatchStatus_TX_WATCH_UNKNOWN
+}
+
+func (m *TxWatchEvent) GetFinal() bool {
+	if m != nil {
+		return m.Final
+	}
+	return false
+}
+
+func (m *TxWatchEvent) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *TxWatchEvent) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *TxWatchEvent) GetReason() TxDropReason {
+	if m != nil {
+		return m.Reason
+	}
+	return TxDropReason_TX_DROP_NONE
+}
+
+func (m *TxWatchEvent) GetReplacedBy() []byte {
+	if m != nil {
+		return m.ReplacedBy
+	}
+	return nil
+}
+
+type WatchTransactionsResponse struct {
+	Events []*TxWatchEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// With auth the response encrypted to the reply key, events are left empty.
+	Crypted              []byte   `protobuf:"bytes,2,opt,name=crypted,proto3" json:"crypted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchTransactionsResponse) Reset()         { *m = WatchTransactionsResponse{} }
+func (m *WatchTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchTransactionsResponse) ProtoMessage()    {}
+func (*WatchTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{80}
+}
+func (m *WatchTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchTransactionsResponse.Unmarshal(m, b)
+}
+func (m *WatchTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchTransactionsResponse.Marshal(b, m, deterministic)
+}
+func (m *WatchTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchTransactionsResponse.Merge(m, src)
+}
+func (m *WatchTransactionsResponse) XXX_Size() int {
+	return xxx_messageInfo_WatchTransactionsResponse.Size(m)
+}
+func (m *WatchTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchTransactionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchTransactionsResponse proto.InternalMessageInfo
+
+func (m *WatchTransactionsResponse) GetEvents() []*TxWatchEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *WatchTransactionsResponse) GetCrypted() []byte {
+	if m != nil {
+		return m.Crypted
+	}
+	return nil
+}
+
+// PoolConfig mirrors the configuration of the transaction pool.
+type PoolConfig struct {
+	NoLocals             bool                 `protobuf:"varint,1,opt,name=no_locals,json=noLocals,proto3" json:"no_locals,omitempty"`
+	Journal              string               `protobuf:"bytes,2,opt,name=journal,proto3" json:"journal,omitempty"`
+	Rejournal            *durationpb.Duration `protobuf:"bytes,3,opt,name=rejournal,proto3" json:"rejournal,omitempty"`
+	PriceLimit           uint64               `protobuf:"varint,4,opt,name=price_limit,json=priceLimit,proto3" json:"price_limit,omitempty"`
+	PriceBump            uint64               `protobuf:"varint,5,opt,name=price_bump,json=priceBump,proto3" json:"price_bump,omitempty"`
+	AccountSlots         uint64               `protobuf:"varint,6,opt,name=account_slots,json=accountSlots,proto3" json:"account_slots,omitempty"`
+	GlobalSlots          uint64               `protobuf:"varint,7,opt,name=global_slots,json=globalSlots,proto3" json:"global_slots,omitempty"`
+	AccountQueue         uint64               `protobuf:"varint,8,opt,name=account_queue,json=accountQueue,proto3" json:"account_queue,omitempty"`
+	GlobalQueue          uint64               `protobuf:"varint,9,opt,name=global_queue,json=globalQueue,proto3" json:"global_queue,omitempty"`
+	Lifetime             *durationpb.Duration `protobuf:"bytes,10,opt,name=lifetime,proto3" json:"lifetime,omitempty"`
+	MaxReorgDepth        uint64               `protobuf:"varint,11,opt,name=max_reorg_depth,json=maxReorgDepth,proto3" json:"max_reorg_depth,omitempty"`
+	HistorySlots         uint64               `protobuf:"varint,12,opt,name=history_slots,json=historySlots,proto3" json:"history_slots,omitempty"`
+	HistoryLifetime      *durationpb.Duration `protobuf:"bytes,13,opt,name=history_lifetime,json=historyLifetime,proto3" json:"history_lifetime,omitempty"`
+	Snapshot             string               `protobuf:"bytes,14,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *PoolConfig) Reset()         { *m = PoolConfig{} }
+func (m *PoolConfig) String() string { return proto.CompactTextString(m) }
+func (*PoolConfig) ProtoMessage()    {}
+func (*PoolConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{81}
+}
+func (m *PoolConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PoolConfig.Unmarshal(m, b)
+}
+func (m *PoolConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PoolConfig.Marshal(b, m, deterministic)
+}
+func (m *PoolConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolConfig.Merge(m, src)
+}
+func (m *PoolConfig) XXX_Size() int {
+	return xxx_messageInfo_PoolConfig.Size(m)
+}
+func (m *PoolConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolConfig proto.InternalMessageInfo
+
+func (m *PoolConfig) GetNoLocals() bool {
+	if m != nil {
+		return m.NoLocals
+	}
+	return false
+}
+
+func (m *PoolConfig) GetJournal() string {
+	if m != nil {
+		return m.Journal
+	}
+	return ""
+}
+
+func (m *PoolConfig) GetRejournal() *durationpb.Duration {
+	if m != nil {
+		return m.Rejournal
+	}
+	return nil
+}
+
+func (m *PoolConfig) GetPriceLimit() uint64 {
+	if m != nil {
+		return m.PriceLimit
+	}
+	return 0
+}
+
+func (m *PoolConfig) GetPriceBump() uint64 {
+	if m != nil {
+		return m.PriceBump
+	}
+	return 0
+}
+
+func (m *PoolConfig) GetAccountSlots() uint64 {
+	if m != nil {
+		return m.AccountSlots
+	}
+	return 0
+}
+
+func (m *PoolConfig) GetGlobalSlots() uint64 {
+	if m != nil {
+		return m.GlobalSlots
+	}
+	return 0
+}
+
+func (m *PoolConfig) GetAccountQueue() uint64 {
+	if m != nil {
+		return m.AccountQueue
+	}
+	return 0
+}
+
+func (m *PoolConfig) GetGlobalQueue() uint64 {
+	if m != nil {
+		return m.GlobalQueue
+	}
+	return 0
+}
+
+func (m *PoolConfig) GetLifetime() *durationpb.Duration {
+	if m != nil {
+		return m.Lifetime
+	}
+	return nil
+}
+
+func (m *PoolConfig) GetMaxReorgDepth() uint64 {
+	if m != nil {
+		return m.MaxReorgDepth
+	}
+	return 0
+}
+
+func (m *PoolConfig) GetHistorySlots() uint64 {
+	if m != nil {
+		return m.HistorySlots
+	}
+	return 0
+}
+
+func (m *PoolConfig) GetHistoryLifetime() *durationpb.Duration {
+	if m != nil {
+		return m.HistoryLifetime
+	}
+	return nil
+}
+
+func (m *PoolConfig) GetSnapshot() string {
+	if m != nil {
+		return m.Snapshot
+	}
+	return ""
+}
+
+type PoolConfigResponse struct {
+	Config               *PoolConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *PoolConfigResponse) Reset()         { *m = PoolConfigResponse{} }
+func (m *PoolConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*PoolConfigResponse) ProtoMessage()    {}
+func (*PoolConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{82}
+}
+func (m *PoolConfigResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PoolConfigResponse.Unmarshal(m, b)
+}
+func (m *PoolConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PoolConfigResponse.Marshal(b, m, deterministic)
+}
+func (m *PoolConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolConfigResponse.Merge(m, src)
+}
+func (m *PoolConfigResponse) XXX_Size() int {
+	return xxx_messageInfo_PoolConfigResponse.Size(m)
+}
+func (m *PoolConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolConfigResponse proto.InternalMessageInfo
+
+func (m *PoolConfigResponse) GetConfig() *PoolConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type SetPoolConfigRequest struct {
+	Config *PoolConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// Fields of config to apply, by proto field name. All fields if empty.
+	UpdateMask           *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SetPoolConfigRequest) Reset()         { *m = SetPoolConfigRequest{} }
+func (m *SetPoolConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*SetPoolConfigRequest) ProtoMessage()    {}
+func (*SetPoolConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec141b309055405, []int{83}
+}
+func (m *SetPoolConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetPoolConfigRequest.Unmarshal(m, b)
+}
+func (m *SetPoolConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetPoolConfigRequest.Marshal(b, m, deterministic)
+}
+func (m *SetPoolConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPoolConfigRequest.Merge(m, src)
+}
+func (m *SetPoolConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_SetPoolConfigRequest.Size(m)
+}
+func (m *SetPoolConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPoolConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPoolConfigRequest proto.InternalMessageInfo
+
+func (m *SetPoolConfigRequest) GetConfig() *PoolConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *SetPoolConfigRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("trusted.v1.TxState", TxState_name, TxState_value)
+	proto.RegisterEnum("trusted.v1.TxOrigin", TxOrigin_name, TxOrigin_value)
+	proto.RegisterEnum("trusted.v1.TxErrorCode", TxErrorCode_name, TxErrorCode_value)
+	proto.RegisterEnum("trusted.v1.TxEventKind", TxEventKind_name, TxEventKind_value)
+	proto.RegisterEnum("trusted.v1.TxDropReason", TxDropReason_name, TxDropReason_value)
+	proto.RegisterEnum("trusted.v1.TxWatchStatus", TxWatchStatus_name, TxWatchStatus_value)
 	proto.RegisterType((*ServiceReadyResponse)(nil), "trusted.v1.ServiceReadyResponse")
 	proto.RegisterType((*SetPriceRequest)(nil), "trusted.v1.SetPriceRequest")
 	proto.RegisterType((*GasPriceResponse)(nil), "trusted.v1.GasPriceResponse")
+	proto.RegisterType((*OwnerAuth)(nil), "trusted.v1.OwnerAuth")
 	proto.RegisterType((*PendingNonceRequest)(nil), "trusted.v1.PendingNonceRequest")
 	proto.RegisterType((*PendingNonceResponse)(nil), "trusted.v1.PendingNonceResponse")
 	proto.RegisterType((*PoolStatResponse)(nil), "trusted.v1.PoolStatResponse")
@@ -2427,26 +4197,39 @@ func init() {
 	proto.RegisterType((*PoolContentResponse)(nil), "trusted.v1.PoolContentResponse")
 	proto.RegisterType((*PoolPendingResponse)(nil), "trusted.v1.PoolPendingResponse")
 	proto.RegisterType((*PoolLocalsResponse)(nil), "trusted.v1.PoolLocalsResponse")
+	proto.RegisterType((*PoolQueryRequest)(nil), "trusted.v1.PoolQueryRequest")
+	proto.RegisterType((*PoolTransaction)(nil), "trusted.v1.PoolTransaction")
+	proto.RegisterType((*PoolQueryResponse)(nil), "trusted.v1.PoolQueryResponse")
 	proto.RegisterType((*AddTxsRequest)(nil), "trusted.v1.AddTxsRequest")
+	proto.RegisterType((*TxError)(nil), "trusted.v1.TxError")
 	proto.RegisterType((*AddTxsResponse)(nil), "trusted.v1.AddTxsResponse")
 	proto.RegisterType((*TxStatusRequest)(nil), "trusted.v1.TxStatusRequest")
 	proto.RegisterType((*TxStatusResponse)(nil), "trusted.v1.TxStatusResponse")
+	proto.RegisterType((*TxStatusDetail)(nil), "trusted.v1.TxStatusDetail")
 	proto.RegisterType((*TxGetRequest)(nil), "trusted.v1.TxGetRequest")
 	proto.RegisterType((*TxGetResponse)(nil), "trusted.v1.TxGetResponse")
 	proto.RegisterType((*TxHasRequest)(nil), "trusted.v1.TxHasRequest")
 	proto.RegisterType((*TxHasResponse)(nil), "trusted.v1.TxHasResponse")
 	proto.RegisterType((*BlockRequest)(nil), "trusted.v1.BlockRequest")
 	proto.RegisterType((*BlockResponse)(nil), "trusted.v1.BlockResponse")
+	proto.RegisterType((*BlockRangeRequest)(nil), "trusted.v1.BlockRangeRequest")
+	proto.RegisterType((*BlocksResponse)(nil), "trusted.v1.BlocksResponse")
 	proto.RegisterType((*BalanceRequest)(nil), "trusted.v1.BalanceRequest")
 	proto.RegisterType((*BalanceResponse)(nil), "trusted.v1.BalanceResponse")
 	proto.RegisterType((*NonceRequest)(nil), "trusted.v1.NonceRequest")
 	proto.RegisterType((*NonceResponse)(nil), "trusted.v1.NonceResponse")
+	proto.RegisterType((*HeaderRequest)(nil), "trusted.v1.HeaderRequest")
+	proto.RegisterType((*HeaderResponse)(nil), "trusted.v1.HeaderResponse")
 	proto.RegisterType((*LatestHeaderRequest)(nil), "trusted.v1.LatestHeaderRequest")
 	proto.RegisterType((*LatestHeaderResponse)(nil), "trusted.v1.LatestHeaderResponse")
 	proto.RegisterType((*CurrentBlockRequest)(nil), "trusted.v1.CurrentBlockRequest")
 	proto.RegisterType((*CurrentBlockResponse)(nil), "trusted.v1.CurrentBlockResponse")
 	proto.RegisterType((*ChainHeadEventRequest)(nil), "trusted.v1.ChainHeadEventRequest")
 	proto.RegisterType((*ChainHeadEventResponse)(nil), "trusted.v1.ChainHeadEventResponse")
+	proto.RegisterType((*ChainInfoRequest)(nil), "trusted.v1.ChainInfoRequest")
+	proto.RegisterType((*ChainInfoResponse)(nil), "trusted.v1.ChainInfoResponse")
+	proto.RegisterType((*ChainHeaderEventRequest)(nil), "trusted.v1.ChainHeaderEventRequest")
+	proto.RegisterType((*ChainHeaderEventResponse)(nil), "trusted.v1.ChainHeaderEventResponse")
 	proto.RegisterType((*CryptRequest)(nil), "trusted.v1.CryptRequest")
 	proto.RegisterType((*CryptResponse)(nil), "trusted.v1.CryptResponse")
 	proto.RegisterType((*AddTrustedTxsRequest)(nil), "trusted.v1.AddTrustedTxsRequest")
@@ -2476,93 +4259,222 @@ func init() {
 	proto.RegisterType((*CommittedBlockVerifyResponse)(nil), "trusted.v1.CommittedBlockVerifyResponse")
 	proto.RegisterType((*SubscribeNewTxRequest)(nil), "trusted.v1.SubscribeNewTxRequest")
 	proto.RegisterType((*SubscribeNewTxResponse)(nil), "trusted.v1.SubscribeNewTxResponse")
+	proto.RegisterType((*SubscribeTxEventsRequest)(nil), "trusted.v1.SubscribeTxEventsRequest")
+	proto.RegisterType((*TxEvent)(nil), "trusted.v1.TxEvent")
+	proto.RegisterType((*SubscribeTxEventsResponse)(nil), "trusted.v1.SubscribeTxEventsResponse")
+	proto.RegisterType((*WatchTransactionsRequest)(nil), "trusted.v1.WatchTransactionsRequest")
+	proto.RegisterType((*TxWatchEvent)(nil), "trusted.v1.TxWatchEvent")
+	proto.RegisterType((*WatchTransactionsResponse)(nil), "trusted.v1.WatchTransactionsResponse")
+	proto.RegisterType((*PoolConfig)(nil), "trusted.v1.PoolConfig")
+	proto.RegisterType((*PoolConfigResponse)(nil), "trusted.v1.PoolConfigResponse")
+	proto.RegisterType((*SetPoolConfigRequest)(nil), "trusted.v1.SetPoolConfigRequest")
 }
 
 func init() { proto.RegisterFile("trusted/v1/request_response.proto", fileDescriptor_8ec141b309055405) }
 
 var fileDescriptor_8ec141b309055405 = []byte{
-	// 1313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x5f, 0x6f, 0x1a, 0x47,
-	0x10, 0x17, 0x38, 0xb5, 0xcd, 0x00, 0x36, 0xc1, 0xd8, 0x21, 0x76, 0x1a, 0x27, 0xdb, 0x56, 0x71,
-	0xd3, 0x04, 0x84, 0x93, 0x2a, 0x51, 0x5a, 0x55, 0x32, 0x8e, 0x8b, 0xf3, 0xa7, 0x11, 0x39, 0x53,
-	0x2b, 0xaa, 0x22, 0xd1, 0xe5, 0x6e, 0x6d, 0xae, 0x86, 0x3b, 0xb2, 0xbb, 0x47, 0xce, 0x9f, 0xa6,
-	0x52, 0x1f, 0xfb, 0xde, 0x2f, 0xd1, 0x8f, 0xd1, 0xc7, 0x7e, 0x8a, 0x6a, 0x77, 0xe7, 0xb8, 0x3b,
-	0x4c, 0x6c, 0x1e, 0xfa, 0xc4, 0xcd, 0xdc, 0x6f, 0x7e, 0xf3, 0x6f, 0x77, 0x86, 0x83, 0xbb, 0x92,
-	0x07, 0x42, 0x32, 0xa7, 0x3e, 0x6e, 0xd4, 0x39, 0xfb, 0x10, 0x30, 0x21, 0xbb, 0x9c, 0x89, 0x91,
-	0xef, 0x09, 0x56, 0x1b, 0x71, 0x5f, 0xfa, 0x65, 0x40, 0x48, 0x6d, 0xdc, 0xd8, 0xbc, 0x73, 0xea,
-	0xfb, 0xa7, 0x03, 0x56, 0xd7, 0x6f, 0x7a, 0xc1, 0x49, 0xfd, 0xc4, 0x65, 0x03, 0xa7, 0x3b, 0xa4,
-	0xe2, 0xcc, 0xa0, 0x37, 0xb7, 0xa7, 0x11, 0xd2, 0x1d, 0x32, 0x21, 0xe9, 0x70, 0x64, 0x00, 0xe4,
-	0x01, 0x54, 0x8e, 0x18, 0x1f, 0xbb, 0x36, 0xb3, 0x18, 0x75, 0xce, 0x2d, 0x74, 0x56, 0xae, 0xc0,
-	0x67, 0x5c, 0x29, 0xaa, 0x99, 0x3b, 0x99, 0x9d, 0x65, 0xcb, 0x08, 0xe4, 0x1e, 0xac, 0x1e, 0x31,
-	0xd9, 0xe6, 0x1a, 0xae, 0xc3, 0x53, 0xc0, 0x91, 0x92, 0x35, 0xb0, 0x60, 0x19, 0x81, 0xec, 0x40,
-	0xa9, 0x45, 0x05, 0x02, 0x63, 0xca, 0x19, 0xc8, 0x3a, 0xac, 0xb5, 0x99, 0xe7, 0xb8, 0xde, 0xe9,
-	0x1b, 0xdf, 0x8b, 0x69, 0xab, 0xb0, 0x44, 0x1d, 0x87, 0x33, 0x21, 0x10, 0x1e, 0x89, 0x2a, 0xe2,
-	0xb4, 0x41, 0x4c, 0xef, 0x29, 0x85, 0xc6, 0x5f, 0xb3, 0x8c, 0x40, 0x9a, 0x50, 0x6a, 0xfb, 0xfe,
-	0xe0, 0x48, 0x52, 0x39, 0x41, 0x56, 0x61, 0x69, 0x64, 0x18, 0x10, 0x1b, 0x89, 0x8a, 0xe3, 0x43,
-	0xc0, 0x02, 0x56, 0xcd, 0x1a, 0x0e, 0x2d, 0x90, 0x1a, 0x94, 0x15, 0xc7, 0xbe, 0xef, 0x49, 0xe6,
-	0xc9, 0xab, 0x23, 0xfc, 0x02, 0x56, 0x3b, 0x9c, 0x7a, 0x82, 0xda, 0xd2, 0xf5, 0xbd, 0xd7, 0xae,
-	0x90, 0xe5, 0x12, 0x2c, 0xc8, 0x50, 0x01, 0x17, 0x76, 0x0a, 0x96, 0x7a, 0x24, 0x7d, 0xd8, 0xd8,
-	0xb3, 0x6d, 0x3f, 0xf0, 0xe4, 0x34, 0xf6, 0x93, 0xc4, 0xe5, 0xc7, 0xb0, 0x24, 0xc3, 0xee, 0xc0,
-	0x15, 0x52, 0x07, 0x98, 0xdf, 0xdd, 0xaa, 0xc5, 0xa7, 0xa1, 0x36, 0xc5, 0x63, 0x2d, 0xca, 0x50,
-	0xfd, 0x92, 0xdf, 0x33, 0xb0, 0x96, 0x8a, 0x1f, 0xcb, 0x70, 0x00, 0x05, 0xcc, 0xdb, 0x50, 0xaa,
-	0xe0, 0xf2, 0xbb, 0x24, 0x49, 0x39, 0x3b, 0x42, 0x2b, 0x8f, 0x76, 0x3a, 0xdc, 0x3d, 0x00, 0x5d,
-	0xa6, 0x28, 0xae, 0x79, 0x49, 0x72, 0xda, 0x4a, 0x47, 0xf8, 0xde, 0x04, 0x88, 0x6d, 0xfd, 0x9f,
-	0x03, 0x24, 0x4f, 0x4c, 0xfb, 0x5e, 0xfb, 0x36, 0x1d, 0x88, 0x09, 0xf9, 0x5d, 0x28, 0x60, 0x59,
-	0x63, 0xf2, 0x82, 0x95, 0x47, 0x9d, 0x36, 0x3c, 0x80, 0xe2, 0x9e, 0xe3, 0x74, 0x42, 0x11, 0xb5,
-	0x3c, 0x51, 0xff, 0xcc, 0xfc, 0xf5, 0xdf, 0x81, 0x95, 0x88, 0x06, 0x7d, 0x6f, 0xc0, 0x22, 0xe3,
-	0xdc, 0xe7, 0xe6, 0x40, 0xe4, 0x2c, 0x94, 0xc8, 0x03, 0x58, 0xed, 0x84, 0xea, 0xa8, 0x06, 0x13,
-	0x97, 0x37, 0x61, 0x59, 0x86, 0xdd, 0x3e, 0x15, 0xfd, 0xe8, 0xf4, 0x2c, 0xc9, 0xf0, 0x50, 0x89,
-	0xa4, 0x0e, 0xa5, 0x18, 0x8d, 0xcc, 0x5b, 0x90, 0x93, 0x61, 0x57, 0x68, 0xa5, 0xc6, 0x17, 0xad,
-	0x65, 0x89, 0x20, 0x72, 0x0f, 0x0a, 0x9d, 0xb0, 0xc5, 0x26, 0x27, 0xf8, 0x86, 0x4e, 0x47, 0x71,
-	0xe3, 0x41, 0x5b, 0x34, 0xd4, 0x64, 0x1b, 0x8a, 0x08, 0x44, 0xda, 0x15, 0xc8, 0xca, 0x10, 0x41,
-	0x59, 0x19, 0x1a, 0xa6, 0x43, 0x2a, 0xae, 0x64, 0xba, 0xab, 0x98, 0x34, 0x10, 0x99, 0x4a, 0xb0,
-	0xd0, 0xa7, 0x02, 0xa7, 0x8a, 0x7a, 0x24, 0x2f, 0xa1, 0xd0, 0x1c, 0xf8, 0xf6, 0x59, 0xc4, 0xf5,
-	0x39, 0x40, 0x4f, 0xc9, 0x49, 0xba, 0x9c, 0xd6, 0x28, 0x46, 0x95, 0xa1, 0x79, 0xed, 0x05, 0x43,
-	0xbc, 0xa6, 0xcb, 0x5a, 0xf1, 0x26, 0x18, 0x92, 0x1a, 0x14, 0x91, 0x0b, 0xdd, 0x4d, 0xc8, 0x1c,
-	0x2a, 0x69, 0x8a, 0xec, 0x39, 0x95, 0x94, 0xb4, 0x60, 0xa5, 0x49, 0x07, 0x74, 0x9e, 0xb9, 0x73,
-	0xd1, 0x71, 0x21, 0xe1, 0xf8, 0x1b, 0x58, 0x9d, 0x10, 0xc5, 0x53, 0xa6, 0x67, 0x54, 0x11, 0x13,
-	0x8a, 0xe4, 0x00, 0x0a, 0xf3, 0xcd, 0xba, 0xcb, 0x7d, 0x7e, 0x05, 0xc5, 0x79, 0x26, 0xe0, 0x3a,
-	0xac, 0xbd, 0xa6, 0x92, 0x09, 0x79, 0xc8, 0xa8, 0xc3, 0x38, 0x3a, 0x25, 0x1d, 0xa8, 0xa4, 0xd5,
-	0xf1, 0x09, 0x8a, 0x5d, 0x66, 0xd2, 0x2e, 0xcb, 0xdb, 0x90, 0xef, 0x6b, 0x78, 0xf7, 0x37, 0xe1,
-	0x7b, 0x18, 0x11, 0x18, 0xd5, 0x4b, 0xe1, 0x7b, 0xca, 0xd9, 0x7e, 0xc0, 0x39, 0xf3, 0x64, 0xb2,
-	0xa7, 0xe4, 0x5b, 0xa8, 0xa4, 0xd5, 0xf3, 0xb5, 0xe7, 0x06, 0xac, 0xef, 0xf7, 0xa9, 0xeb, 0xa9,
-	0x10, 0x0f, 0xc6, 0xf1, 0xec, 0x25, 0x4f, 0x60, 0x63, 0xfa, 0xc5, 0x7c, 0x8c, 0xcf, 0xa0, 0xb0,
-	0xcf, 0xcf, 0x47, 0x93, 0x2b, 0xb0, 0x01, 0x8b, 0x43, 0x26, 0xfb, 0xbe, 0xa3, 0xa1, 0x45, 0x0b,
-	0xa5, 0x72, 0x19, 0xae, 0x69, 0x02, 0x93, 0xa1, 0x7e, 0x26, 0x5f, 0x43, 0x11, 0x6d, 0xe3, 0x0e,
-	0xdb, 0x4a, 0xc1, 0x9c, 0xa8, 0x6f, 0x28, 0x92, 0x27, 0x50, 0x51, 0x57, 0xde, 0xcc, 0x86, 0xc4,
-	0x00, 0xd9, 0x86, 0xbc, 0x2d, 0x35, 0xa4, 0x1b, 0xaf, 0x03, 0x40, 0x55, 0x27, 0x14, 0xa4, 0x03,
-	0xe5, 0xa4, 0xa1, 0xc5, 0x44, 0x30, 0x90, 0x2a, 0x9a, 0xc4, 0x65, 0xd0, 0xcf, 0xaa, 0xd9, 0x54,
-	0x08, 0x26, 0x31, 0x44, 0x23, 0x28, 0xad, 0x9e, 0x25, 0xd5, 0x85, 0x3b, 0x99, 0x9d, 0x9c, 0x65,
-	0x04, 0xf2, 0x16, 0xd6, 0xa7, 0xc2, 0xc1, 0x0c, 0x9e, 0xc2, 0x12, 0xd7, 0x2e, 0x04, 0x0e, 0xd7,
-	0xdb, 0xa9, 0xe1, 0x7a, 0x21, 0x12, 0x2b, 0x82, 0x9b, 0xd6, 0x30, 0xfb, 0xec, 0x88, 0xd9, 0x9c,
-	0xc9, 0x57, 0xec, 0x3c, 0x6a, 0x4d, 0x4d, 0xb5, 0x26, 0xfd, 0x22, 0x3e, 0x9e, 0x2c, 0x8c, 0x66,
-	0xe7, 0xb2, 0x65, 0x04, 0xf2, 0x10, 0xca, 0x2d, 0x26, 0xf7, 0x02, 0xd9, 0x57, 0x0d, 0x4a, 0x0c,
-	0x94, 0x11, 0x63, 0xbc, 0xeb, 0x9a, 0xd2, 0xe6, 0xac, 0x45, 0x25, 0xbe, 0x70, 0xc8, 0x2e, 0xac,
-	0xa5, 0xe0, 0xf1, 0xa9, 0xa5, 0x81, 0xec, 0x27, 0xbb, 0xbe, 0x4c, 0x11, 0x44, 0x5e, 0xc0, 0xf5,
-	0x63, 0xc6, 0xdd, 0x93, 0x73, 0x65, 0x76, 0x95, 0x87, 0x34, 0x55, 0x76, 0x8a, 0xea, 0x3e, 0x94,
-	0x93, 0x54, 0x89, 0xcc, 0x74, 0xd5, 0x33, 0xc9, 0xaa, 0xd7, 0xa1, 0xd2, 0x62, 0xd2, 0xc0, 0xe7,
-	0xca, 0xed, 0x29, 0xac, 0x4f, 0x19, 0x20, 0xff, 0x36, 0xe4, 0xc7, 0x5a, 0x9b, 0xcc, 0x0f, 0xc6,
-	0x13, 0x20, 0xf9, 0x19, 0x6e, 0x1a, 0x33, 0x8b, 0x0d, 0x7d, 0xc9, 0xa2, 0xe7, 0x2b, 0x32, 0x9d,
-	0xa2, 0xcd, 0x5e, 0xa0, 0xdd, 0x85, 0xcd, 0x59, 0xb4, 0x97, 0x66, 0xfd, 0x08, 0xaa, 0xf1, 0x8a,
-	0x79, 0xc5, 0xe6, 0xcb, 0xfc, 0x00, 0x6e, 0xce, 0x30, 0x42, 0x3f, 0x3b, 0x50, 0x8a, 0xfe, 0x0b,
-	0x9f, 0xb1, 0x54, 0x09, 0x56, 0x78, 0xca, 0x82, 0xfc, 0x0a, 0x5b, 0xa9, 0xd4, 0xe7, 0x74, 0x3f,
-	0xd3, 0x43, 0x76, 0xa6, 0x87, 0xc7, 0x70, 0x6b, 0xb6, 0x87, 0x4b, 0x6b, 0xf2, 0x18, 0xd3, 0x33,
-	0xa0, 0x79, 0x8b, 0x72, 0x08, 0x9b, 0xb3, 0xac, 0xd0, 0xd3, 0x7d, 0xb8, 0x1e, 0x7d, 0x19, 0x4c,
-	0x97, 0x65, 0x95, 0xa7, 0x6d, 0x48, 0x17, 0xaa, 0xe9, 0xde, 0xc5, 0xf7, 0xf5, 0xd3, 0x45, 0x99,
-	0xe9, 0x20, 0x3b, 0xdb, 0x41, 0x23, 0x3e, 0x7f, 0x09, 0x07, 0x97, 0xd6, 0xe4, 0x2d, 0x94, 0x7e,
-	0x74, 0x07, 0x83, 0xd4, 0xea, 0xdf, 0x86, 0xfc, 0x88, 0xaa, 0x2d, 0x91, 0xdc, 0xfd, 0x60, 0x54,
-	0x7a, 0xf9, 0xdf, 0x82, 0xdc, 0xe4, 0x03, 0x06, 0x97, 0x7f, 0xac, 0x20, 0xbb, 0x70, 0x3d, 0x41,
-	0x19, 0x2f, 0x04, 0xe1, 0xf3, 0x78, 0xe2, 0xea, 0x85, 0x60, 0x34, 0x6a, 0xe0, 0x7e, 0x0f, 0x5b,
-	0xfb, 0xfe, 0x70, 0xe8, 0x4a, 0xc9, 0x1c, 0x6d, 0x98, 0xbe, 0x3b, 0x57, 0xac, 0x93, 0xdb, 0x70,
-	0x6b, 0xb6, 0xb5, 0x71, 0xae, 0xa6, 0xe4, 0x51, 0xd0, 0x13, 0x36, 0x77, 0x7b, 0xec, 0x0d, 0xfb,
-	0xa8, 0xc6, 0xa8, 0x99, 0x92, 0x3f, 0xc0, 0xc6, 0xf4, 0x0b, 0x8c, 0xf7, 0x4b, 0x58, 0xc1, 0x2d,
-	0xd2, 0xf5, 0xd8, 0xc7, 0xae, 0xfe, 0xdb, 0xa5, 0xb6, 0x44, 0x01, 0xb5, 0x1a, 0xdd, 0xfc, 0x2b,
-	0x03, 0x2b, 0xb6, 0x3f, 0x4c, 0x4c, 0xeb, 0x66, 0x05, 0xb9, 0x23, 0xa6, 0xb6, 0xfa, 0xbe, 0x6b,
-	0x67, 0x7e, 0x79, 0x7e, 0xea, 0xca, 0x7e, 0xd0, 0xab, 0xd9, 0xfe, 0xb0, 0x8e, 0xf0, 0x87, 0x0e,
-	0x3b, 0x71, 0x27, 0x02, 0xf3, 0x4e, 0x5d, 0x0f, 0x3f, 0x11, 0x6d, 0x7f, 0x50, 0x8f, 0xbf, 0x41,
-	0xbf, 0xc3, 0xc7, 0x71, 0xe3, 0x8f, 0xec, 0x42, 0xe7, 0xdd, 0xbb, 0x3f, 0xb3, 0x80, 0x0b, 0xa1,
-	0x76, 0xdc, 0xf8, 0x7b, 0x22, 0xbc, 0x3f, 0x6e, 0xfc, 0x93, 0xdd, 0x88, 0x85, 0xf7, 0xad, 0x76,
-	0xf3, 0x27, 0x26, 0xa9, 0xaa, 0xd9, 0xbf, 0xd9, 0x3c, 0xbe, 0x78, 0xf6, 0xec, 0xb8, 0xd1, 0x5b,
-	0xd4, 0x5e, 0x1e, 0xfd, 0x17, 0x00, 0x00, 0xff, 0xff, 0xd8, 0xc7, 0xd2, 0xf3, 0xe9, 0x0e, 0x00,
-	0x00,
+	// 3245 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcb, 0x73, 0x1b, 0xc7,
+	0xd1, 0x37, 0x1e, 0xc4, 0xa3, 0xf1, 0xe0, 0x72, 0xf9, 0x10, 0x48, 0xea, 0xd3, 0x63, 0xed, 0xef,
+	0xb3, 0x2c, 0x7f, 0x22, 0x4d, 0xda, 0x2e, 0x39, 0x76, 0x0e, 0x01, 0x81, 0x25, 0x09, 0x09, 0x5c,
+	0x40, 0x83, 0x25, 0x25, 0xaa, 0x5c, 0xb5, 0x59, 0x02, 0x43, 0x60, 0x4d, 0x60, 0x17, 0xde, 0x1d,
+	0xd0, 0xa0, 0x2f, 0x71, 0x55, 0x52, 0x95, 0x53, 0xfe, 0x85, 0x1c, 0x72, 0x49, 0x95, 0x8f, 0xa9,
+	0xca, 0x21, 0x95, 0x4b, 0xce, 0x39, 0xe4, 0x9c, 0x73, 0x8e, 0xf9, 0x2b, 0x52, 0xf3, 0xd8, 0x17,
+	0x08, 0x89, 0x74, 0x45, 0x27, 0xa2, 0x7f, 0xd3, 0xd3, 0xd3, 0xdd, 0xd3, 0xd3, 0xd3, 0xd3, 0x4b,
+	0x78, 0x48, 0xdc, 0x89, 0x47, 0x70, 0x6f, 0xfb, 0x72, 0x67, 0xdb, 0xc5, 0xdf, 0x4e, 0xb0, 0x47,
+	0x0c, 0x17, 0x7b, 0x63, 0xc7, 0xf6, 0xf0, 0xd6, 0xd8, 0x75, 0x88, 0x23, 0x83, 0x60, 0xd9, 0xba,
+	0xdc, 0xd9, 0xb8, 0xd7, 0x77, 0x9c, 0xfe, 0x10, 0x6f, 0xb3, 0x91, 0xb3, 0xc9, 0xf9, 0x76, 0x6f,
+	0xe2, 0x9a, 0xc4, 0x72, 0x6c, 0xce, 0xbb, 0xf1, 0x60, 0x76, 0xfc, 0xdc, 0xc2, 0xc3, 0x9e, 0x31,
+	0x32, 0xbd, 0x0b, 0xc1, 0x71, 0x7f, 0x96, 0x83, 0x58, 0x23, 0xec, 0x11, 0x73, 0x34, 0xe6, 0x0c,
+	0xca, 0x3e, 0xac, 0x74, 0xb0, 0x7b, 0x69, 0x75, 0x31, 0xc2, 0x66, 0xef, 0x0a, 0x09, 0x65, 0xe4,
+	0x15, 0x58, 0x70, 0x29, 0x50, 0x49, 0x3c, 0x48, 0x3c, 0xca, 0x21, 0x4e, 0xc8, 0x15, 0xc8, 0xba,
+	0xd8, 0xf4, 0x1c, 0xdb, 0xab, 0x24, 0x1f, 0xa4, 0x1e, 0xe5, 0x91, 0x4f, 0x2a, 0x1f, 0xc2, 0x62,
+	0x07, 0x93, 0xb6, 0xcb, 0x04, 0x31, 0xc3, 0xa8, 0x88, 0x31, 0xa5, 0x99, 0x88, 0x22, 0xe2, 0x84,
+	0xf2, 0x08, 0xa4, 0x03, 0xd3, 0x13, 0x8c, 0xe1, 0x62, 0x73, 0x38, 0x09, 0xe4, 0x5b, 0xdf, 0xd9,
+	0xd8, 0xad, 0x4e, 0xc8, 0x80, 0xb2, 0xd8, 0x8e, 0x2d, 0x58, 0xd2, 0x88, 0x13, 0xf2, 0x1a, 0x64,
+	0xf0, 0x74, 0x6c, 0xb9, 0x57, 0x95, 0x24, 0x83, 0x05, 0x25, 0x6f, 0x42, 0xde, 0xc5, 0xe3, 0xe1,
+	0x95, 0x71, 0x81, 0xaf, 0x2a, 0x29, 0x26, 0x34, 0xc7, 0x80, 0xe7, 0xf8, 0x4a, 0xbe, 0x0b, 0x79,
+	0xcf, 0xea, 0xdb, 0x26, 0x99, 0xb8, 0xb8, 0x92, 0x66, 0x83, 0x21, 0xa0, 0xbc, 0x86, 0xe5, 0x36,
+	0xb6, 0x7b, 0x96, 0xdd, 0xd7, 0x1c, 0x3b, 0x34, 0xa6, 0x02, 0x59, 0xb3, 0xd7, 0x73, 0xb1, 0xe7,
+	0x09, 0x25, 0x7d, 0x52, 0xfe, 0x08, 0xd2, 0xe6, 0x84, 0x0c, 0x98, 0x06, 0x85, 0xdd, 0xd5, 0xad,
+	0x70, 0xff, 0xb6, 0x02, 0xf5, 0x11, 0x63, 0xa1, 0xce, 0x8e, 0xcb, 0x0e, 0xed, 0x9f, 0x63, 0x5c,
+	0x05, 0xb2, 0x5d, 0xf7, 0x6a, 0x4c, 0x70, 0x8f, 0xc9, 0x2e, 0x22, 0x9f, 0x54, 0x7e, 0x48, 0x80,
+	0xd4, 0x76, 0x9c, 0x61, 0x87, 0x98, 0x24, 0x10, 0x52, 0x81, 0xec, 0x98, 0x0b, 0x17, 0x62, 0x7c,
+	0x92, 0x8a, 0xff, 0x76, 0x82, 0x27, 0x58, 0x38, 0x89, 0x13, 0xf2, 0x06, 0xe4, 0xcc, 0x6e, 0xd7,
+	0x99, 0xd8, 0xc4, 0x63, 0x2e, 0x4a, 0xa3, 0x80, 0x96, 0x1f, 0x40, 0xa1, 0xef, 0x9a, 0xf6, 0x64,
+	0x68, 0xba, 0x16, 0xb9, 0x62, 0x4e, 0x4a, 0xa3, 0x28, 0xa4, 0x9c, 0x82, 0x4c, 0x35, 0xa8, 0x39,
+	0x36, 0xc1, 0x36, 0x79, 0xa7, 0x5e, 0x7a, 0x1f, 0x16, 0x75, 0xd7, 0xb4, 0x3d, 0xb3, 0x4b, 0x43,
+	0xbd, 0x69, 0x79, 0x44, 0x96, 0x20, 0x45, 0xa6, 0x54, 0x66, 0xea, 0x51, 0x11, 0xd1, 0x9f, 0xca,
+	0x00, 0xd6, 0xaa, 0x5c, 0xdb, 0x59, 0xde, 0x37, 0xeb, 0xf0, 0x19, 0x64, 0xc9, 0xd4, 0x18, 0x5a,
+	0x1e, 0x11, 0x6a, 0x6c, 0x46, 0xd5, 0x98, 0x91, 0x83, 0x32, 0x64, 0x4a, 0xff, 0x2a, 0x7f, 0x49,
+	0xc0, 0x72, 0xcc, 0x54, 0xe1, 0x6f, 0x15, 0x8a, 0xc2, 0xc1, 0x5c, 0x24, 0x55, 0xae, 0xb0, 0xab,
+	0x44, 0x45, 0xce, 0xd7, 0x10, 0x15, 0xc4, 0x3c, 0xa6, 0x6e, 0x15, 0x80, 0xed, 0x87, 0xaf, 0xd7,
+	0x6d, 0x85, 0xe4, 0xd9, 0x2c, 0xdf, 0x62, 0x3f, 0x50, 0x52, 0xf1, 0x40, 0xf9, 0x9a, 0xab, 0x2e,
+	0x82, 0xee, 0x1d, 0xab, 0xae, 0x3c, 0xe5, 0x31, 0xd0, 0x74, 0xba, 0xe6, 0xd0, 0x0b, 0x84, 0x3f,
+	0x84, 0xa2, 0x70, 0x78, 0x28, 0xbc, 0x88, 0x0a, 0x02, 0x63, 0x13, 0xff, 0x26, 0xe2, 0xf7, 0xc5,
+	0x04, 0xbb, 0x57, 0x7e, 0xec, 0x7c, 0x04, 0x0b, 0x1e, 0x31, 0x09, 0x3f, 0x04, 0xe5, 0xdd, 0xe5,
+	0xd8, 0xde, 0x4c, 0x69, 0xa8, 0x63, 0xc4, 0x39, 0xe4, 0xff, 0x87, 0x8c, 0xe3, 0x5a, 0x7d, 0xcb,
+	0x66, 0xfb, 0x58, 0xde, 0x5d, 0x89, 0xf3, 0xb6, 0xd8, 0x18, 0x12, 0x3c, 0xf2, 0x1d, 0xc8, 0x8e,
+	0x2c, 0xdb, 0x20, 0xd6, 0x58, 0xb8, 0x27, 0x33, 0xb2, 0x6c, 0xdd, 0x1a, 0xd3, 0x2c, 0x31, 0x36,
+	0xfb, 0xd8, 0xf0, 0xac, 0xef, 0x79, 0x22, 0x28, 0xa1, 0x1c, 0x05, 0x3a, 0xd6, 0xf7, 0x58, 0xfe,
+	0x1f, 0x00, 0x36, 0x48, 0x9c, 0x0b, 0x6c, 0x57, 0x16, 0x78, 0x9a, 0xa0, 0x88, 0x4e, 0x01, 0xc5,
+	0x82, 0x45, 0x6a, 0x41, 0xc4, 0x3f, 0x34, 0x19, 0x79, 0xd8, 0xee, 0x61, 0x57, 0xc4, 0x9d, 0xa0,
+	0xe4, 0x32, 0x24, 0xc9, 0x54, 0x1c, 0xe1, 0x24, 0x99, 0x46, 0x0f, 0x6a, 0x8a, 0x25, 0xd7, 0xe8,
+	0x41, 0x1d, 0x52, 0x67, 0x32, 0x65, 0x72, 0x88, 0x13, 0xca, 0x37, 0xb0, 0x14, 0x71, 0x96, 0xf0,
+	0xf2, 0x93, 0xf0, 0x44, 0xcc, 0xc4, 0xf1, 0x8c, 0x5a, 0xec, 0xb8, 0xc8, 0xff, 0x07, 0x8b, 0x36,
+	0x9e, 0x12, 0x23, 0x62, 0x12, 0x57, 0xa8, 0x44, 0xe1, 0x76, 0x60, 0x96, 0x0a, 0xa5, 0x6a, 0xaf,
+	0xa7, 0x4f, 0x3d, 0x7f, 0x57, 0x22, 0x67, 0x26, 0x71, 0xfb, 0x33, 0xd3, 0x86, 0xac, 0x3e, 0x55,
+	0x5d, 0xd7, 0x71, 0xe5, 0x8f, 0x21, 0xdd, 0x75, 0x7a, 0xfe, 0xae, 0xde, 0x89, 0xef, 0x14, 0x63,
+	0xa9, 0x39, 0x3d, 0x8c, 0x18, 0x13, 0x75, 0xcd, 0x08, 0x7b, 0x9e, 0xd9, 0xe7, 0xb9, 0x2a, 0x8f,
+	0x7c, 0x52, 0xa9, 0x41, 0xd9, 0x57, 0x4c, 0x78, 0xe0, 0x63, 0xc8, 0x60, 0x3a, 0xdd, 0x13, 0x87,
+	0x66, 0x79, 0x8e, 0x68, 0x24, 0x58, 0x9e, 0xa5, 0x73, 0x09, 0x29, 0xa9, 0x8c, 0x60, 0x91, 0x47,
+	0xd2, 0x24, 0xb0, 0x6f, 0x1d, 0x72, 0x64, 0x6a, 0x0c, 0x4c, 0x6f, 0xe0, 0xa7, 0x97, 0x2c, 0x99,
+	0x1e, 0x52, 0x92, 0xee, 0x86, 0x43, 0x53, 0x93, 0xf0, 0x14, 0x27, 0x82, 0x44, 0x96, 0xba, 0x39,
+	0x91, 0xfd, 0x0a, 0xa4, 0x70, 0x39, 0xa1, 0xf5, 0x26, 0xe4, 0xc9, 0xd4, 0xf0, 0x18, 0xc8, 0x16,
+	0x2c, 0xa1, 0x1c, 0x11, 0x4c, 0xd4, 0xd9, 0x3d, 0x4c, 0x4c, 0x6b, 0xe8, 0xdb, 0xb4, 0x71, 0xfd,
+	0x10, 0x4c, 0xbc, 0x3a, 0x63, 0x41, 0x3e, 0xeb, 0x5b, 0x8e, 0xff, 0x6f, 0x12, 0x50, 0x8e, 0xcf,
+	0xa2, 0x61, 0x7d, 0x36, 0x74, 0xba, 0x17, 0xcc, 0x64, 0x11, 0xa8, 0x79, 0x86, 0x50, 0xa3, 0xe9,
+	0xe1, 0xe5, 0xc3, 0xf6, 0x64, 0x74, 0x26, 0x4c, 0x4f, 0xa3, 0x02, 0xc3, 0x34, 0x06, 0xc9, 0x9f,
+	0x40, 0x86, 0x5f, 0xfa, 0x6c, 0xb5, 0xf2, 0x6e, 0x25, 0xae, 0x63, 0xdd, 0x75, 0xc6, 0x88, 0x8d,
+	0x23, 0xc1, 0xa7, 0x20, 0x28, 0xea, 0xd3, 0x03, 0x1c, 0xdc, 0x12, 0x77, 0x58, 0x4c, 0x45, 0x14,
+	0xc8, 0x70, 0x97, 0xff, 0x94, 0x4b, 0xe2, 0x67, 0x50, 0x12, 0x32, 0x85, 0x63, 0xf9, 0x29, 0x4b,
+	0x44, 0x4f, 0xd9, 0x1b, 0x6e, 0xcf, 0x0f, 0xa9, 0x3a, 0x87, 0xa6, 0x77, 0x93, 0x3a, 0xca, 0x43,
+	0x28, 0x09, 0x46, 0xb1, 0x86, 0x04, 0xa9, 0x81, 0xe9, 0x89, 0x92, 0x88, 0xfe, 0x54, 0x9e, 0x41,
+	0x71, 0x8f, 0xfa, 0xc6, 0x97, 0x75, 0x83, 0x7b, 0x37, 0x21, 0x1f, 0xb8, 0x57, 0xf8, 0x36, 0xe7,
+	0xfb, 0x56, 0xd9, 0x82, 0x92, 0x90, 0x25, 0x96, 0x0b, 0x84, 0xf5, 0x4c, 0x62, 0xc6, 0x84, 0xd5,
+	0x4d, 0x62, 0x2a, 0x18, 0x96, 0x38, 0xbf, 0x69, 0xf7, 0xf1, 0x3b, 0x50, 0x80, 0x06, 0x3c, 0x4b,
+	0xfa, 0xa2, 0x1c, 0xe0, 0x84, 0xb2, 0x0d, 0x65, 0xb6, 0x8c, 0xf7, 0x46, 0xbd, 0x52, 0x71, 0xbd,
+	0x0e, 0xa0, 0xbc, 0x67, 0x0e, 0xcd, 0x5b, 0x15, 0x4f, 0xd7, 0xf4, 0x29, 0x46, 0x1c, 0xf2, 0x31,
+	0x2c, 0x06, 0x82, 0xc2, 0x22, 0xe7, 0x8c, 0x43, 0xbe, 0x24, 0x41, 0x2a, 0x2a, 0x14, 0x35, 0xe7,
+	0xbf, 0x5f, 0xf3, 0x7f, 0xa1, 0x74, 0x8b, 0xda, 0x4c, 0x79, 0x0e, 0xa5, 0x43, 0x6c, 0xf6, 0xb0,
+	0xfb, 0x2e, 0x36, 0x7e, 0x07, 0xca, 0xbe, 0x30, 0xb1, 0xe8, 0x7d, 0x28, 0x0c, 0x18, 0x12, 0xdd,
+	0x7a, 0xe0, 0x10, 0xf3, 0xf1, 0x2a, 0x2c, 0x37, 0x4d, 0x82, 0x3d, 0x12, 0xd3, 0x42, 0xd1, 0x61,
+	0x25, 0x0e, 0x87, 0x59, 0x27, 0x5c, 0x3e, 0x11, 0x37, 0x39, 0xb2, 0xd8, 0x37, 0xf4, 0x54, 0x27,
+	0xa3, 0x8b, 0x3d, 0xa3, 0xe7, 0x77, 0x15, 0x96, 0x6b, 0x13, 0xd7, 0xc5, 0x36, 0x89, 0xc6, 0xba,
+	0xf2, 0x39, 0xac, 0xc4, 0xe1, 0xdb, 0x85, 0xed, 0x1d, 0x58, 0xad, 0x0d, 0x4c, 0xcb, 0xa6, 0x2a,
+	0xaa, 0x97, 0x61, 0xf1, 0xa8, 0x3c, 0x85, 0xb5, 0xd9, 0x81, 0xdb, 0x49, 0x3c, 0x05, 0x89, 0x4d,
+	0x6c, 0xd8, 0xe7, 0x4e, 0x58, 0x4d, 0x48, 0xec, 0x81, 0xd3, 0x75, 0x86, 0xc6, 0x25, 0x76, 0x3d,
+	0xcb, 0xb1, 0xd9, 0xc4, 0x12, 0x5a, 0xf4, 0xf1, 0x13, 0x0e, 0xd3, 0x42, 0xf8, 0x1c, 0xb3, 0xe2,
+	0xdf, 0x7f, 0xd5, 0x04, 0xb4, 0xf2, 0xd7, 0x04, 0x2c, 0x45, 0x64, 0x0b, 0x7d, 0x7e, 0x82, 0xf0,
+	0x75, 0xc8, 0x75, 0xe9, 0x7c, 0xc3, 0x0a, 0xf3, 0x10, 0x93, 0xd7, 0xa3, 0xb9, 0xb6, 0x8f, 0x6d,
+	0xec, 0x59, 0x1e, 0x0f, 0x1a, 0x9e, 0xbc, 0x0b, 0x02, 0xf3, 0xd3, 0x31, 0x9f, 0xdd, 0x75, 0xec,
+	0x73, 0xab, 0x2f, 0x5e, 0x2b, 0x05, 0x86, 0xd5, 0x18, 0x14, 0xd3, 0x7e, 0x61, 0x46, 0xfb, 0x75,
+	0xb8, 0x13, 0x78, 0x14, 0xbb, 0x31, 0x67, 0x7f, 0x05, 0x95, 0xeb, 0x43, 0xb7, 0x8d, 0xbe, 0x2f,
+	0xa1, 0x58, 0xa3, 0xc9, 0xd4, 0x77, 0xf6, 0x1a, 0x64, 0x46, 0x98, 0x0c, 0x9c, 0x9e, 0xf0, 0x82,
+	0xa0, 0x64, 0x19, 0xd2, 0x4c, 0x02, 0x37, 0x9c, 0xfd, 0x56, 0x3e, 0x82, 0x92, 0x98, 0x1b, 0x1e,
+	0x69, 0x3f, 0x51, 0x27, 0xe2, 0x89, 0xfa, 0x29, 0xac, 0xd0, 0x3b, 0x9f, 0x5f, 0x02, 0x91, 0x9a,
+	0xe4, 0x3e, 0x14, 0xba, 0x84, 0xb1, 0x18, 0xe1, 0xab, 0x00, 0x04, 0xa4, 0x4f, 0x3d, 0xc5, 0x01,
+	0x39, 0x3a, 0x11, 0x61, 0x6f, 0x32, 0x24, 0x54, 0x9b, 0xc8, 0xe1, 0x64, 0xbf, 0xe9, 0xe9, 0x36,
+	0x3d, 0x0f, 0x13, 0xff, 0x8e, 0x67, 0x04, 0x2d, 0x45, 0x59, 0xdd, 0xc0, 0xfc, 0xfd, 0x86, 0xca,
+	0x82, 0x73, 0x3c, 0x4b, 0xe7, 0x52, 0x52, 0x5a, 0x79, 0x01, 0xab, 0x33, 0x9a, 0x0a, 0xe3, 0xbe,
+	0xa0, 0x0f, 0x66, 0xba, 0xba, 0x5f, 0xaa, 0xdd, 0x8b, 0x15, 0xd9, 0xd7, 0x94, 0x44, 0x3e, 0x3b,
+	0x3f, 0x26, 0xb8, 0x7b, 0xd1, 0xc1, 0x5d, 0x17, 0x93, 0xe7, 0xd8, 0xaf, 0x93, 0x95, 0x2d, 0x58,
+	0x9b, 0x1d, 0x08, 0x53, 0x15, 0x9e, 0xfa, 0x95, 0x5a, 0x0e, 0x71, 0x42, 0x79, 0x02, 0xf2, 0x01,
+	0x26, 0xf4, 0xea, 0xa4, 0x7b, 0x17, 0xb9, 0xf4, 0xc6, 0x18, 0xbb, 0x34, 0x2c, 0x13, 0xac, 0xd2,
+	0xca, 0x50, 0xb2, 0xd1, 0x53, 0x76, 0x61, 0x39, 0xc6, 0x1e, 0x66, 0x10, 0x7a, 0xef, 0x46, 0x23,
+	0x22, 0x67, 0x0a, 0x26, 0xa5, 0x01, 0x4b, 0x27, 0xd8, 0xb5, 0xce, 0xaf, 0xe8, 0xb4, 0x9b, 0x56,
+	0x88, 0x8b, 0x4a, 0xce, 0x88, 0xda, 0x00, 0x39, 0x2a, 0x8a, 0xaf, 0x2e, 0xca, 0xb7, 0x6d, 0x58,
+	0x39, 0xc0, 0x84, 0x0f, 0xdf, 0xca, 0x96, 0x2f, 0x60, 0x75, 0x66, 0x42, 0x18, 0xe1, 0x97, 0x0c,
+	0x8d, 0x45, 0xf8, 0x65, 0xc0, 0xa8, 0x1c, 0xc3, 0x3a, 0x9f, 0x86, 0xf0, 0xc8, 0x21, 0xd8, 0xff,
+	0x7d, 0x83, 0x65, 0x33, 0x62, 0x93, 0xd7, 0xc4, 0x2a, 0xb0, 0x31, 0x4f, 0x6c, 0xcc, 0xca, 0x4f,
+	0xa1, 0x12, 0xd6, 0x4a, 0xcf, 0xf1, 0xed, 0x2c, 0x55, 0x61, 0x7d, 0xce, 0x24, 0x61, 0xed, 0x23,
+	0x90, 0xfc, 0x66, 0xd3, 0x05, 0x8e, 0x99, 0x5c, 0x76, 0x63, 0x33, 0x94, 0x5f, 0xc2, 0x66, 0xcc,
+	0xd4, 0x5b, 0x2e, 0x3f, 0x77, 0x85, 0xe4, 0xdc, 0x15, 0x3e, 0x80, 0xbb, 0xf3, 0x57, 0x88, 0xf9,
+	0xe0, 0x33, 0x61, 0x0e, 0x07, 0x6f, 0xeb, 0x84, 0x43, 0xd8, 0x98, 0x37, 0x8b, 0x93, 0xf2, 0x63,
+	0x58, 0xf2, 0x5b, 0x6d, 0xb3, 0x6e, 0x58, 0x74, 0xe3, 0x73, 0x14, 0x03, 0x2a, 0xf1, 0xbd, 0x09,
+	0xcf, 0xdf, 0x9b, 0x9d, 0x30, 0x77, 0x81, 0xe4, 0xfc, 0x05, 0x1e, 0x86, 0xf1, 0x15, 0x59, 0x20,
+	0xe6, 0x83, 0x17, 0x20, 0xed, 0x5b, 0xc3, 0x61, 0xac, 0xbc, 0xbc, 0x0f, 0x85, 0xb1, 0x49, 0x6f,
+	0xdc, 0x68, 0x99, 0x01, 0x1c, 0x62, 0x17, 0xc6, 0x5d, 0xc8, 0x07, 0x1d, 0x3e, 0x51, 0x67, 0x84,
+	0x80, 0xb2, 0x0b, 0x4b, 0x11, 0x91, 0xe1, 0xe5, 0xea, 0x39, 0x6e, 0x98, 0x4c, 0x79, 0x3f, 0xcc,
+	0x71, 0x45, 0x2e, 0xfd, 0x39, 0x6c, 0xd6, 0x9c, 0xd1, 0xc8, 0x22, 0x04, 0xf7, 0xd8, 0xc4, 0xf8,
+	0x59, 0xb8, 0xe1, 0x6a, 0xbe, 0x07, 0x77, 0xe7, 0xcf, 0xe6, 0x8b, 0x2b, 0xbf, 0x4d, 0xc2, 0x6a,
+	0x67, 0x72, 0xe6, 0x75, 0x5d, 0xeb, 0x0c, 0x6b, 0xf8, 0x3b, 0x7d, 0xea, 0x0b, 0xae, 0x40, 0x96,
+	0xbf, 0x9f, 0x83, 0x77, 0x99, 0x20, 0xe5, 0x7b, 0x00, 0x2e, 0xee, 0x5a, 0x63, 0x0b, 0xdb, 0x84,
+	0xdf, 0xd8, 0x45, 0x14, 0x41, 0xc4, 0x93, 0x8e, 0x5c, 0x8d, 0x31, 0x6d, 0x6c, 0xd1, 0x17, 0x56,
+	0x96, 0x4c, 0x75, 0x4a, 0x46, 0x5b, 0x01, 0xe9, 0xd9, 0x56, 0xc0, 0xc8, 0x9c, 0x1a, 0x67, 0x26,
+	0xe9, 0x0e, 0xd8, 0x63, 0xbf, 0x84, 0x72, 0x23, 0x73, 0xba, 0x47, 0x69, 0xf9, 0x17, 0x50, 0x3e,
+	0x1f, 0x4e, 0xbc, 0x81, 0x61, 0xd9, 0x04, 0xbb, 0x97, 0xe6, 0xb0, 0x92, 0x61, 0xf7, 0xc2, 0xfa,
+	0x16, 0xef, 0xae, 0x6e, 0xf9, 0xdd, 0xd5, 0xad, 0xba, 0xe8, 0xcf, 0xa2, 0x12, 0x9b, 0xd0, 0x10,
+	0xfc, 0xf4, 0x1e, 0xa7, 0x79, 0x7d, 0x84, 0x0d, 0xf3, 0x9c, 0x60, 0xb7, 0x92, 0xe5, 0xcf, 0x2a,
+	0x8e, 0x55, 0x29, 0xa4, 0xbc, 0x86, 0xb5, 0x59, 0x47, 0x88, 0x0d, 0xfa, 0x00, 0xca, 0xe2, 0x46,
+	0x34, 0x6c, 0xfc, 0x9d, 0xc1, 0x5e, 0x39, 0xd4, 0xe6, 0xa2, 0x40, 0x19, 0x37, 0xad, 0x03, 0x3c,
+	0xea, 0x3a, 0x5a, 0xaa, 0x8a, 0x02, 0xd3, 0xa7, 0x95, 0x2e, 0x54, 0x02, 0xd9, 0xfa, 0x94, 0x5d,
+	0xf6, 0xde, 0xcd, 0x7e, 0x7e, 0x02, 0x0b, 0x17, 0x96, 0xdd, 0xe3, 0x2e, 0xbe, 0xfe, 0x74, 0xa7,
+	0x52, 0x9e, 0x5b, 0x76, 0x0f, 0x71, 0x2e, 0xe5, 0x77, 0x49, 0xc8, 0x0a, 0x98, 0x3e, 0xfa, 0x29,
+	0xf8, 0x86, 0x47, 0x7f, 0x30, 0x93, 0x31, 0x05, 0xf7, 0x72, 0x32, 0x72, 0x2f, 0x87, 0xbd, 0x94,
+	0x54, 0xac, 0x97, 0x12, 0x54, 0xe3, 0xe9, 0x68, 0xa7, 0xf4, 0x3e, 0x14, 0x68, 0x77, 0xd7, 0xec,
+	0xe2, 0x9e, 0x71, 0x76, 0x25, 0x9a, 0x35, 0xe0, 0x43, 0x7b, 0x57, 0x91, 0x37, 0x6b, 0xe6, 0x76,
+	0x6f, 0xd6, 0x99, 0x7a, 0x3e, 0x7b, 0xd3, 0x3b, 0x39, 0x77, 0xed, 0x9d, 0xac, 0x1c, 0xc2, 0xfa,
+	0x1c, 0xa7, 0x47, 0x9a, 0x17, 0x0c, 0x11, 0x65, 0xc1, 0xf2, 0x1c, 0x17, 0x21, 0xc1, 0xa2, 0xfc,
+	0x23, 0x01, 0x95, 0x97, 0x34, 0x12, 0x23, 0xed, 0x16, 0x2f, 0x52, 0x7b, 0x51, 0x15, 0xb1, 0xbf,
+	0x7d, 0x82, 0x92, 0x3f, 0x85, 0x2c, 0x3d, 0xf8, 0xce, 0xc4, 0x6f, 0x76, 0xbe, 0x25, 0x5a, 0x7d,
+	0x4e, 0xf9, 0x03, 0x28, 0xb1, 0x4a, 0xd3, 0x1d, 0xb1, 0x01, 0xde, 0x18, 0x2e, 0xa1, 0x38, 0x18,
+	0x36, 0x46, 0xd2, 0xf3, 0x1a, 0x23, 0x0b, 0x37, 0x3f, 0xde, 0x7f, 0x48, 0xd2, 0x27, 0x38, 0x33,
+	0x89, 0xc7, 0xcb, 0xbc, 0xd2, 0x6c, 0x07, 0x32, 0xa2, 0x4d, 0xc2, 0x9b, 0x7c, 0xeb, 0x71, 0x17,
+	0xb1, 0xd9, 0xa2, 0xb9, 0x22, 0x18, 0xa9, 0x62, 0xe7, 0x96, 0x6d, 0x0e, 0x45, 0x5f, 0x8d, 0x13,
+	0x33, 0x5b, 0x99, 0xbe, 0x69, 0x2b, 0x17, 0xde, 0xd6, 0xf2, 0xb8, 0x6d, 0xf8, 0xcc, 0x44, 0x64,
+	0x76, 0x36, 0x22, 0x95, 0x3e, 0xac, 0xcf, 0xd9, 0x52, 0x11, 0x1d, 0x9f, 0xcc, 0x44, 0x47, 0x65,
+	0x8e, 0xe9, 0xb1, 0x10, 0x79, 0x4b, 0xb7, 0xe3, 0x4f, 0x69, 0x00, 0xd1, 0xbe, 0xa6, 0xcf, 0x85,
+	0x4d, 0xc8, 0xdb, 0x8e, 0xc1, 0x1a, 0x8b, 0x7e, 0x23, 0x23, 0x67, 0x3b, 0xbc, 0x85, 0x4b, 0xa5,
+	0x7c, 0xe3, 0x4c, 0x5c, 0xea, 0x41, 0xd1, 0x7e, 0x13, 0xa4, 0xfc, 0x94, 0x7e, 0x50, 0xf1, 0xc7,
+	0x52, 0x37, 0xc5, 0x53, 0xc8, 0xcb, 0x6e, 0x2c, 0xfa, 0x35, 0xc7, 0x18, 0x5a, 0x23, 0x8b, 0x88,
+	0x63, 0x0b, 0x0c, 0x6a, 0x52, 0x84, 0xf5, 0x59, 0x19, 0xc3, 0xd9, 0x64, 0x34, 0x16, 0xce, 0xcf,
+	0x33, 0x64, 0x6f, 0x32, 0x1a, 0xcb, 0xef, 0x43, 0x49, 0x7c, 0x95, 0x30, 0xbc, 0xa1, 0x43, 0x3c,
+	0xb6, 0x03, 0x69, 0x54, 0x14, 0x60, 0x87, 0x62, 0xec, 0x25, 0x35, 0x74, 0xce, 0xcc, 0xa1, 0xe0,
+	0x11, 0xe9, 0x95, 0x63, 0x9c, 0x25, 0x22, 0x87, 0x7f, 0x0b, 0xc9, 0xc5, 0xe4, 0xbc, 0xa0, 0x58,
+	0x44, 0x0e, 0xe7, 0xc9, 0x47, 0xe5, 0x70, 0x96, 0xcf, 0x21, 0x37, 0xb4, 0xce, 0x31, 0x3d, 0x30,
+	0x15, 0xb8, 0xc9, 0x0f, 0x01, 0x2b, 0xed, 0xbf, 0xd2, 0xfb, 0xc5, 0xc5, 0x8e, 0xdb, 0x37, 0x7a,
+	0x78, 0x4c, 0x06, 0x95, 0x02, 0x13, 0x5e, 0x1a, 0x99, 0x53, 0x44, 0xd1, 0x3a, 0x05, 0xa9, 0x9a,
+	0x03, 0xcb, 0x23, 0x8e, 0x7b, 0x25, 0x4c, 0x29, 0x72, 0x35, 0x05, 0xc8, 0x6d, 0xa9, 0x83, 0xe4,
+	0x33, 0x05, 0xba, 0x94, 0x6e, 0xd2, 0x65, 0x51, 0x4c, 0x69, 0xfa, 0x2a, 0xd1, 0x0b, 0xc3, 0x36,
+	0xc7, 0xde, 0xc0, 0x21, 0x95, 0x32, 0xdb, 0xed, 0x80, 0x56, 0xea, 0xc1, 0xd7, 0x9d, 0x73, 0x2b,
+	0xfc, 0x6c, 0xb0, 0x05, 0x19, 0xf1, 0x0e, 0xe5, 0xad, 0xe0, 0xb5, 0xd9, 0xb6, 0xb3, 0xe0, 0x17,
+	0x5c, 0xca, 0xaf, 0x13, 0xf4, 0xe3, 0x22, 0x89, 0x4a, 0xe2, 0x39, 0xeb, 0x27, 0x0a, 0x92, 0xbf,
+	0x82, 0xc2, 0x64, 0xdc, 0x33, 0x09, 0x66, 0x9f, 0x36, 0x45, 0x3e, 0xdb, 0xb8, 0x66, 0xeb, 0x3e,
+	0xfd, 0xfa, 0x79, 0x64, 0x7a, 0x17, 0x08, 0x38, 0x3b, 0xfd, 0xfd, 0x78, 0x9f, 0x5e, 0x4b, 0xec,
+	0xf3, 0x81, 0x2c, 0x41, 0x51, 0x7f, 0x65, 0x74, 0xf4, 0xaa, 0xae, 0x1a, 0x55, 0xed, 0x54, 0x7a,
+	0x4f, 0x5e, 0x01, 0x29, 0x40, 0xda, 0xaa, 0x56, 0x6f, 0x68, 0x07, 0x52, 0x42, 0x5e, 0x86, 0xc5,
+	0x00, 0x7d, 0x71, 0xac, 0x1e, 0xab, 0x75, 0x29, 0xf9, 0xf8, 0x10, 0x72, 0xfe, 0xa7, 0x05, 0x79,
+	0x09, 0x4a, 0xfa, 0x2b, 0xa3, 0x85, 0x1a, 0x07, 0x0d, 0x4d, 0x48, 0xe2, 0x73, 0x04, 0xd4, 0x6c,
+	0xd5, 0xaa, 0x4d, 0x29, 0x21, 0xc4, 0x0b, 0x10, 0xa9, 0x47, 0x2d, 0x5d, 0x95, 0x92, 0x8f, 0x7f,
+	0x9f, 0x86, 0x42, 0xa4, 0xf7, 0x2d, 0xa4, 0xa9, 0x08, 0xb5, 0x90, 0xa1, 0xb5, 0x34, 0x55, 0x7a,
+	0x4f, 0x5e, 0x03, 0x39, 0x80, 0x8e, 0xaa, 0xcd, 0xfd, 0x16, 0x3a, 0x52, 0xeb, 0x81, 0x40, 0x8e,
+	0xd7, 0xd5, 0x1a, 0x3a, 0x6d, 0xeb, 0x52, 0x52, 0xde, 0x80, 0xb5, 0x00, 0xad, 0x36, 0x91, 0x5a,
+	0xad, 0x9f, 0x1a, 0xcf, 0xb5, 0xd6, 0x4b, 0x4d, 0x4a, 0xc9, 0x9b, 0x70, 0x27, 0x18, 0x6b, 0x68,
+	0x27, 0xd5, 0x66, 0xa3, 0x6e, 0x74, 0x54, 0xad, 0xae, 0x22, 0x29, 0x2d, 0x57, 0x60, 0x25, 0x18,
+	0x3c, 0xa6, 0x58, 0x1b, 0x35, 0x6a, 0x6a, 0x5d, 0x5a, 0x90, 0x1f, 0xc0, 0xdd, 0x60, 0x04, 0xa9,
+	0xed, 0x66, 0xb5, 0xa6, 0xc6, 0x38, 0x32, 0xb1, 0x45, 0xdb, 0xad, 0x56, 0xd3, 0x68, 0x9d, 0xa8,
+	0x68, 0xbf, 0xd9, 0x7a, 0x29, 0x65, 0x63, 0xea, 0x1f, 0x54, 0x3b, 0x46, 0xb3, 0x71, 0xd4, 0xd0,
+	0xa5, 0x5c, 0x4c, 0x19, 0x4d, 0x3d, 0xa8, 0xea, 0x8d, 0x13, 0xd5, 0x38, 0xa9, 0x36, 0x8f, 0x55,
+	0x29, 0x1f, 0x1b, 0xa4, 0xb2, 0x3a, 0x8d, 0xd7, 0x6a, 0xdd, 0xa8, 0x57, 0xf5, 0xaa, 0x04, 0xb1,
+	0xd5, 0xb4, 0x96, 0x56, 0x53, 0x0d, 0xbd, 0xd5, 0x32, 0xe8, 0x6a, 0x05, 0xf9, 0x3e, 0x6c, 0x46,
+	0x4c, 0xec, 0x1c, 0xef, 0xef, 0x37, 0x6a, 0x0d, 0x55, 0xd3, 0x8d, 0xfd, 0x63, 0xad, 0xde, 0x91,
+	0x8a, 0xb1, 0xc9, 0x0d, 0x4d, 0x47, 0x0d, 0xad, 0xd3, 0xa8, 0x51, 0xc5, 0xa4, 0x52, 0x6c, 0xb2,
+	0x7e, 0xda, 0x56, 0x0d, 0xad, 0xa5, 0x1b, 0x9d, 0xe3, 0x76, 0xbb, 0x85, 0x74, 0xb5, 0x2e, 0x95,
+	0xe5, 0x7b, 0xb0, 0x11, 0x30, 0xec, 0xab, 0xaa, 0x51, 0xab, 0xb6, 0x8d, 0x13, 0x15, 0x9d, 0x1a,
+	0x87, 0x8d, 0x83, 0x43, 0x69, 0x31, 0x26, 0x5c, 0x6f, 0x44, 0xc7, 0xa4, 0xd8, 0x5c, 0x3a, 0x56,
+	0xdd, 0x6b, 0x9d, 0xa8, 0xbe, 0x14, 0x69, 0x49, 0x5e, 0x85, 0xa5, 0xa8, 0x62, 0x2a, 0xd2, 0xaa,
+	0x4d, 0x49, 0x7e, 0xfc, 0xcf, 0x04, 0x0b, 0x10, 0xbf, 0x4e, 0xf2, 0x77, 0xfd, 0x84, 0xda, 0x74,
+	0xac, 0xf1, 0x9d, 0xf5, 0x23, 0x8e, 0xa3, 0x22, 0x4a, 0x13, 0xbe, 0x44, 0x06, 0xb6, 0x51, 0x8b,
+	0x46, 0x5c, 0x5d, 0x4a, 0xc6, 0x24, 0xd4, 0x55, 0x8e, 0xa6, 0x62, 0xcc, 0x62, 0x93, 0xeb, 0x52,
+	0x3a, 0xce, 0x8c, 0x5a, 0xed, 0x36, 0x8b, 0x88, 0x3b, 0xb0, 0x1c, 0x61, 0x6e, 0x68, 0xcf, 0xd4,
+	0x9a, 0xce, 0x02, 0x21, 0x2a, 0xa5, 0xa1, 0xd5, 0x9a, 0xc7, 0x75, 0xb5, 0x2e, 0x65, 0x63, 0x52,
+	0x90, 0xda, 0x42, 0x07, 0x6a, 0x5d, 0xca, 0x3d, 0xfe, 0x23, 0xbb, 0xfa, 0xc3, 0x1b, 0x53, 0x9c,
+	0x49, 0xba, 0x8c, 0x1f, 0xfb, 0x7c, 0x21, 0x86, 0x44, 0x23, 0x2e, 0x11, 0x1d, 0xd8, 0x53, 0x9b,
+	0xad, 0x97, 0x06, 0x1b, 0x09, 0xac, 0x63, 0x03, 0xcd, 0xc6, 0xbe, 0xaa, 0x37, 0x8e, 0x54, 0x29,
+	0x25, 0xaf, 0xc3, 0x6a, 0x44, 0x72, 0x24, 0x62, 0xd2, 0x42, 0x65, 0xb1, 0x44, 0xbb, 0x7a, 0x5a,
+	0xdd, 0x6b, 0xaa, 0xd2, 0x42, 0x74, 0x46, 0xb5, 0x56, 0x6b, 0x1d, 0x6b, 0xba, 0x88, 0xdc, 0x4c,
+	0x74, 0x48, 0xe4, 0x09, 0x31, 0x94, 0x8d, 0xaa, 0xc5, 0xb6, 0x21, 0x88, 0x76, 0x19, 0xca, 0xfe,
+	0x40, 0xeb, 0x58, 0xdf, 0x6b, 0xd4, 0xa5, 0x7c, 0x14, 0x43, 0x6a, 0xe7, 0x54, 0xab, 0x49, 0x10,
+	0x55, 0x3f, 0xd8, 0x85, 0xc2, 0xe3, 0x1f, 0x13, 0x50, 0x12, 0x77, 0x7d, 0xc7, 0x2f, 0x6f, 0x28,
+	0xdf, 0xcb, 0xaa, 0x5e, 0x3b, 0xbc, 0x16, 0x06, 0x1c, 0x0d, 0xc2, 0x20, 0xca, 0xea, 0xe7, 0xb5,
+	0xa4, 0x30, 0x9b, 0xa3, 0xc1, 0x4a, 0xa9, 0x18, 0xb3, 0xbf, 0xdf, 0xe9, 0x18, 0x73, 0xb0, 0xad,
+	0x0b, 0x31, 0x66, 0x7f, 0x5b, 0x33, 0x7b, 0x7f, 0x4e, 0x40, 0xb9, 0xeb, 0x8c, 0x22, 0x79, 0x7c,
+	0x6f, 0x45, 0x64, 0x7b, 0xff, 0xfa, 0x68, 0xd3, 0x34, 0xdd, 0x4e, 0xbc, 0xae, 0xf7, 0x2d, 0x32,
+	0x98, 0x9c, 0x6d, 0x75, 0x9d, 0xd1, 0xb6, 0x60, 0x7f, 0xd2, 0xc3, 0xe7, 0x56, 0x40, 0x60, 0xbb,
+	0x6f, 0xd9, 0xe2, 0x5f, 0x56, 0xba, 0xce, 0x70, 0x3b, 0xfc, 0x9f, 0x99, 0xaf, 0xc4, 0xcf, 0xcb,
+	0x9d, 0x3f, 0x24, 0x53, 0xfa, 0xab, 0x57, 0x3f, 0x26, 0x41, 0xf4, 0xcf, 0xb6, 0x4e, 0x76, 0xfe,
+	0x1e, 0x10, 0x5f, 0x9f, 0xec, 0xfc, 0x2b, 0xb9, 0x16, 0x12, 0x5f, 0x1f, 0xb4, 0xf7, 0x8e, 0x30,
+	0x31, 0xe9, 0x13, 0xf5, 0xdf, 0xc9, 0x82, 0x18, 0xf8, 0xf2, 0xcb, 0x93, 0x9d, 0xb3, 0x0c, 0x5b,
+	0xe5, 0xd3, 0xff, 0x0c, 0x00, 0x99, 0xc5, 0xd6, 0x82, 0x99, 0x23, 0x00, 0x00,
 }
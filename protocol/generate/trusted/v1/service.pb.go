@@ -6,6 +6,7 @@ package trustedv1
 import (
 	fmt "fmt"
 	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	_ "google.golang.org/protobuf/types/known/emptypb"
 	math "math"
 )
@@ -24,62 +25,99 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("trusted/v1/service.proto", fileDescriptor_ceb9ab9b8e4b32ac) }
 
 var fileDescriptor_ceb9ab9b8e4b32ac = []byte{
-	// 906 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0xde, 0x96, 0x1f, 0x95, 0x69, 0x29, 0x62, 0x40, 0xcb, 0x36, 0xdd, 0x9f, 0xa4, 0xda, 0x05,
-	0x6e, 0x70, 0xc8, 0x72, 0xb7, 0x48, 0x48, 0x49, 0xb6, 0x71, 0xb4, 0x3f, 0x55, 0x94, 0x58, 0xd1,
-	0x0a, 0x2a, 0xa1, 0x89, 0x7d, 0x9a, 0x58, 0x75, 0x3c, 0xc1, 0x1e, 0x87, 0xe4, 0x75, 0xb8, 0x44,
-	0x42, 0xe2, 0x39, 0x78, 0x0c, 0x2e, 0x79, 0x07, 0x24, 0x64, 0xcf, 0x8f, 0x67, 0x9c, 0xf1, 0x16,
-	0x89, 0x72, 0x67, 0xcf, 0xf7, 0x9d, 0xef, 0x7c, 0x73, 0xce, 0x78, 0x66, 0x8c, 0xee, 0xb1, 0x24,
-	0x4b, 0x19, 0x04, 0xed, 0x75, 0xa7, 0x9d, 0x42, 0xb2, 0x0e, 0x7d, 0x70, 0x56, 0x09, 0x65, 0x14,
-	0x23, 0x81, 0x38, 0xeb, 0x4e, 0xe3, 0x74, 0x4e, 0xe9, 0x3c, 0x82, 0x76, 0x81, 0xcc, 0xb2, 0xab,
-	0x36, 0x2c, 0x57, 0x6c, 0xcb, 0x89, 0x8d, 0x96, 0x26, 0x91, 0xc0, 0x4f, 0x19, 0xa4, 0xec, 0xc7,
-	0x04, 0xd2, 0x15, 0x8d, 0x53, 0xa1, 0xf5, 0xf4, 0x6f, 0x8c, 0x8e, 0x3d, 0xce, 0x9a, 0xf0, 0x24,
-	0xf8, 0x05, 0x3a, 0x12, 0x8f, 0x63, 0x20, 0xc1, 0x16, 0xdf, 0x75, 0x78, 0x0e, 0x47, 0xe6, 0x70,
-	0xce, 0xf3, 0x1c, 0x8d, 0xa6, 0x53, 0xfa, 0x70, 0xf4, 0x88, 0xb1, 0x48, 0x71, 0x76, 0x07, 0x9f,
-	0xa3, 0xa3, 0x11, 0xa5, 0xd1, 0x04, 0xd8, 0x28, 0xc9, 0xb5, 0x4f, 0xcd, 0x18, 0x3e, 0x3a, 0xe6,
-	0xd6, 0x1a, 0x35, 0x89, 0xce, 0xee, 0xe0, 0x01, 0x97, 0x71, 0x49, 0xca, 0x65, 0xea, 0x2c, 0xdd,
-	0xd7, 0xe5, 0x25, 0x5b, 0xb3, 0x33, 0x41, 0x47, 0x23, 0x88, 0x83, 0x30, 0x9e, 0x5f, 0xd0, 0xd8,
-	0x07, 0xfc, 0x48, 0xe7, 0xeb, 0x88, 0xb4, 0xd4, 0xac, 0x27, 0x28, 0xd1, 0x1e, 0x3a, 0x28, 0xe6,
-	0xc8, 0x08, 0xfb, 0x77, 0xc6, 0x24, 0x5b, 0xd3, 0x18, 0xa1, 0xc3, 0x7c, 0xb4, 0x4f, 0x63, 0x06,
-	0x31, 0xc3, 0x0f, 0xab, 0x74, 0x01, 0x48, 0x5b, 0x8f, 0x6a, 0x71, 0xa5, 0xe8, 0xa1, 0x8f, 0x34,
-	0x60, 0x90, 0xd0, 0xe5, 0x6d, 0xa8, 0x0e, 0xb9, 0x4f, 0x51, 0x89, 0xda, 0xe9, 0xee, 0x28, 0x89,
-	0x00, 0x4d, 0x69, 0x80, 0x50, 0x0e, 0xbc, 0xa2, 0x3e, 0x89, 0xd2, 0x5a, 0xa1, 0x1d, 0xcb, 0x9c,
-	0x6f, 0xe8, 0x1c, 0x76, 0x83, 0x80, 0x0f, 0x7b, 0x1b, 0x7c, 0xa2, 0x07, 0x74, 0x83, 0xc0, 0xdb,
-	0xa4, 0x72, 0x7a, 0x0d, 0x1b, 0x54, 0xd1, 0x19, 0xc3, 0x92, 0x32, 0xf8, 0x2f, 0x3a, 0x2e, 0x3a,
-	0xf0, 0x36, 0x79, 0x77, 0xb3, 0xd4, 0x5c, 0xed, 0x72, 0x54, 0xca, 0xdc, 0xb7, 0x83, 0x4a, 0xe8,
-	0x3b, 0xf4, 0x9e, 0xb7, 0x71, 0x81, 0xe1, 0x7b, 0x26, 0xd1, 0x05, 0xd5, 0xb0, 0x13, 0x0b, 0x62,
-	0xc6, 0x0f, 0x49, 0x5a, 0x8d, 0x1f, 0x92, 0xb4, 0x26, 0xbe, 0x40, 0x54, 0x7c, 0x80, 0x3e, 0x9b,
-	0x64, 0xb3, 0xd4, 0x4f, 0xc2, 0x19, 0x5c, 0xc0, 0xcf, 0x5e, 0x42, 0xe2, 0x94, 0xf8, 0x2c, 0xa4,
-	0x31, 0x6e, 0x19, 0x5f, 0xb1, 0x4e, 0xda, 0x48, 0xe9, 0xb3, 0xb7, 0x51, 0x64, 0x8e, 0xaf, 0xf7,
-	0x72, 0x97, 0xfd, 0x64, 0xbb, 0xaa, 0xcc, 0xb2, 0x18, 0xb2, 0xba, 0x14, 0x88, 0x72, 0xf9, 0x03,
-	0xc2, 0xb2, 0xfd, 0x62, 0x1b, 0xf3, 0x36, 0x29, 0x6e, 0x56, 0x5b, 0xa4, 0x20, 0x29, 0xda, 0x7a,
-	0x0b, 0x43, 0x89, 0x5f, 0xa2, 0x4f, 0xca, 0x35, 0x71, 0xeb, 0xea, 0x17, 0xe8, 0xb8, 0xbf, 0x00,
-	0xff, 0x7a, 0x02, 0x7e, 0x02, 0xec, 0x25, 0xd4, 0xef, 0xb4, 0x46, 0x31, 0xcd, 0x18, 0x73, 0x0f,
-	0x71, 0x81, 0x75, 0x33, 0xb6, 0x78, 0x4e, 0x18, 0x31, 0xbf, 0x76, 0x0d, 0xb0, 0x7e, 0xed, 0x06,
-	0xae, 0x14, 0x5f, 0x23, 0x34, 0x85, 0x24, 0xbc, 0xda, 0xe6, 0x18, 0x7e, 0xa0, 0x07, 0x94, 0xe3,
-	0x52, 0xef, 0x61, 0x1d, 0xac, 0xe4, 0xa6, 0xe8, 0x43, 0x17, 0x18, 0x87, 0x0a, 0x8b, 0xcd, 0x8a,
-	0x85, 0x12, 0xb2, 0x16, 0xb2, 0xc2, 0x50, 0xba, 0x80, 0x30, 0x1f, 0xe7, 0x9d, 0xe2, 0xcf, 0xf8,
-	0xc9, 0xae, 0x1f, 0x1d, 0x97, 0x19, 0x3e, 0xbf, 0x89, 0xa6, 0xd2, 0xcc, 0xd0, 0xc7, 0xe5, 0xb7,
-	0xf7, 0x12, 0xf8, 0x14, 0x1e, 0x57, 0x0c, 0x9a, 0xb0, 0x4c, 0xf2, 0xe4, 0x06, 0x96, 0xca, 0x71,
-	0x8d, 0x3e, 0x35, 0xec, 0xc9, 0x34, 0x5f, 0xd8, 0x5c, 0xda, 0x32, 0x7d, 0x79, 0x33, 0x51, 0xaf,
-	0x9b, 0xb6, 0x65, 0xc8, 0x54, 0xbb, 0x5e, 0x0d, 0xdc, 0x5a, 0x37, 0x1b, 0x4d, 0xaf, 0x9b, 0x59,
-	0xcb, 0x7c, 0xa9, 0x3f, 0xb6, 0xf9, 0x54, 0xb0, 0xb5, 0x6e, 0x16, 0x96, 0xca, 0xf1, 0x02, 0x7d,
-	0x30, 0x08, 0xa3, 0xa8, 0x17, 0x51, 0xff, 0x1a, 0x1b, 0x3b, 0xab, 0x1a, 0x96, 0x9a, 0x0f, 0x6a,
-	0x50, 0xbd, 0x07, 0x7d, 0xba, 0x5c, 0x86, 0x8c, 0x41, 0x50, 0x60, 0x62, 0x41, 0x19, 0x3d, 0xb0,
-	0x31, 0xac, 0x3d, 0xb0, 0x13, 0x65, 0xb2, 0xa7, 0xbf, 0xbf, 0x8b, 0x8e, 0xfa, 0x0b, 0x12, 0xc6,
-	0xff, 0xc7, 0xed, 0xab, 0x8b, 0x0e, 0x5c, 0x60, 0xbc, 0x28, 0xc6, 0xfe, 0x6a, 0x14, 0xe4, 0xc4,
-	0x82, 0x68, 0xc7, 0x19, 0xca, 0x25, 0x48, 0x44, 0xf2, 0xfb, 0x92, 0x71, 0xf4, 0x89, 0x41, 0x29,
-	0x73, 0x6a, 0xc5, 0x2a, 0x5e, 0xf8, 0xb5, 0xcb, 0xf0, 0x62, 0xdc, 0xb7, 0x4e, 0x2c, 0x88, 0x7e,
-	0x7b, 0xeb, 0x67, 0x49, 0x02, 0xb1, 0x98, 0x92, 0xb1, 0x83, 0xe9, 0x88, 0xf5, 0xf6, 0x66, 0x12,
-	0x74, 0xd1, 0x57, 0x84, 0x41, 0xca, 0x86, 0x40, 0x02, 0x48, 0x4c, 0x51, 0x1d, 0xb1, 0x8a, 0x9a,
-	0x04, 0xed, 0x54, 0x3a, 0x2e, 0x9a, 0x9a, 0x03, 0xe7, 0xeb, 0xfc, 0x46, 0xd7, 0x32, 0xb7, 0x70,
-	0x1d, 0xb3, 0x1e, 0x99, 0x55, 0x4a, 0x79, 0x64, 0xf6, 0x7e, 0xdb, 0x43, 0xc7, 0x3e, 0x5d, 0x6a,
-	0xec, 0x9e, 0x5c, 0x32, 0xa3, 0x7c, 0x8d, 0x8c, 0xf6, 0xbe, 0x7f, 0x3e, 0x0f, 0xd9, 0x22, 0x9b,
-	0x39, 0x3e, 0x5d, 0xb6, 0x05, 0xed, 0xab, 0x00, 0xae, 0x42, 0xf5, 0x02, 0xf1, 0x3c, 0x8c, 0xc5,
-	0x5f, 0x83, 0x4f, 0xa3, 0x76, 0xf9, 0xa3, 0xf0, 0xad, 0x78, 0x5c, 0x77, 0x7e, 0xd9, 0x7f, 0xc7,
-	0x7b, 0xf3, 0xe6, 0xd7, 0x7d, 0x24, 0x4e, 0x2f, 0x67, 0xda, 0xf9, 0x43, 0xbd, 0x5c, 0x4e, 0x3b,
-	0x7f, 0xee, 0xdf, 0x2d, 0x5f, 0x2e, 0xdd, 0x51, 0xef, 0x35, 0x30, 0x12, 0x10, 0x46, 0xfe, 0xda,
-	0x3f, 0x14, 0xc0, 0xb3, 0x67, 0xd3, 0xce, 0xec, 0xfd, 0x22, 0xcb, 0x37, 0xff, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0xba, 0xa2, 0xfc, 0xc1, 0xd1, 0x0c, 0x00, 0x00,
+	// 1493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcb, 0x72, 0x1b, 0x45,
+	0x14, 0xcd, 0x98, 0x4a, 0x88, 0xdb, 0x8a, 0x1d, 0x75, 0x1c, 0x27, 0x96, 0x1d, 0xc7, 0x9e, 0xd8,
+	0x09, 0x38, 0x58, 0x8a, 0xc2, 0xce, 0xac, 0x6c, 0x25, 0x91, 0x21, 0x8f, 0x32, 0xb6, 0xca, 0x49,
+	0xe5, 0x05, 0xed, 0x99, 0x96, 0x34, 0x65, 0x69, 0x5a, 0x99, 0x69, 0x29, 0x12, 0xb0, 0x62, 0xc3,
+	0x82, 0x2a, 0x52, 0x05, 0xc5, 0x8a, 0x1d, 0x4b, 0x28, 0x7e, 0x81, 0x0f, 0x60, 0xcb, 0x1f, 0x50,
+	0xac, 0x58, 0xf2, 0x05, 0x54, 0x3f, 0x67, 0x7a, 0xd4, 0x93, 0xa4, 0x48, 0x76, 0x9a, 0x3e, 0xb7,
+	0xcf, 0x39, 0xf7, 0xf6, 0x5b, 0xe0, 0x3c, 0x8d, 0xfa, 0x31, 0xc5, 0x7e, 0x65, 0x50, 0xad, 0xc4,
+	0x38, 0x1a, 0x04, 0x1e, 0x2e, 0xf7, 0x22, 0x42, 0x09, 0x04, 0x12, 0x29, 0x0f, 0xaa, 0xa5, 0xc5,
+	0x16, 0x21, 0xad, 0x0e, 0xae, 0xa0, 0x5e, 0x50, 0x41, 0x61, 0x48, 0x28, 0xa2, 0x01, 0x09, 0x63,
+	0x11, 0x59, 0x5a, 0x90, 0x28, 0xff, 0x3a, 0xec, 0x37, 0x2b, 0xb8, 0xdb, 0xa3, 0x23, 0x09, 0xae,
+	0xa4, 0x04, 0x22, 0xfc, 0xac, 0x8f, 0x63, 0xfa, 0x59, 0x84, 0xe3, 0x1e, 0x09, 0x63, 0xa9, 0x74,
+	0xfd, 0xf7, 0x0b, 0x60, 0xba, 0x21, 0xa2, 0xf6, 0x85, 0x05, 0xf8, 0x08, 0x14, 0xe4, 0xcf, 0x3d,
+	0x8c, 0xfc, 0x11, 0x9c, 0x2b, 0x0b, 0x8d, 0xb2, 0xd2, 0x28, 0xdf, 0x64, 0x1a, 0xa5, 0xe5, 0x72,
+	0xe2, 0xb2, 0x9c, 0xee, 0xb1, 0x27, 0x25, 0xdc, 0xe2, 0xd7, 0x7f, 0xfe, 0xfd, 0xc3, 0xc4, 0x14,
+	0x9c, 0x14, 0x06, 0x18, 0xd9, 0x53, 0x50, 0xd8, 0x25, 0xa4, 0xb3, 0x8f, 0xe9, 0x6e, 0xc4, 0xc4,
+	0x16, 0x4c, 0x12, 0xd1, 0xba, 0x27, 0xbc, 0x96, 0x72, 0x94, 0xdd, 0x79, 0xce, 0x7b, 0xc6, 0x9d,
+	0x66, 0xbc, 0x3d, 0x42, 0x3a, 0x95, 0x1e, 0xeb, 0xb6, 0xe9, 0xac, 0xc3, 0xc7, 0x82, 0xbf, 0x8e,
+	0x62, 0xc1, 0x9f, 0x67, 0x7e, 0x31, 0xad, 0xab, 0xa2, 0xb5, 0xf1, 0x39, 0x2e, 0x70, 0x1a, 0x66,
+	0x04, 0x60, 0x07, 0x14, 0x76, 0x71, 0xe8, 0x07, 0x61, 0xeb, 0x1e, 0x09, 0x3d, 0x0c, 0x2f, 0xa6,
+	0x59, 0xd2, 0x88, 0xca, 0x60, 0x39, 0x3f, 0x40, 0x4a, 0x8d, 0xe7, 0x12, 0x92, 0x50, 0xe4, 0x72,
+	0x1f, 0x9c, 0xe4, 0xb5, 0xa2, 0x88, 0xbe, 0x5e, 0x1e, 0x2a, 0x5a, 0x93, 0x9f, 0xe5, 0xe4, 0x33,
+	0xf0, 0x94, 0x26, 0x8f, 0x19, 0xd9, 0x11, 0x98, 0x62, 0xa1, 0x35, 0x12, 0x52, 0x1c, 0x52, 0xb8,
+	0x94, 0xe5, 0x90, 0x80, 0x4a, 0xe2, 0x62, 0x2e, 0x2e, 0x65, 0x16, 0xb8, 0xcc, 0x59, 0xf7, 0xb4,
+	0x96, 0xf1, 0x44, 0x04, 0xcb, 0x82, 0x82, 0x99, 0x54, 0x9f, 0x5b, 0x11, 0xe9, 0xbe, 0xb9, 0xe0,
+	0x32, 0x17, 0x2c, 0xb9, 0x67, 0xb3, 0x82, 0x95, 0x66, 0x44, 0xba, 0x4c, 0xf5, 0x73, 0x91, 0xa2,
+	0x2c, 0x79, 0x6e, 0xf9, 0xc6, 0x94, 0x64, 0x07, 0xad, 0x74, 0x9e, 0x2b, 0x41, 0x98, 0xa4, 0xd6,
+	0x93, 0x94, 0x4f, 0x00, 0x60, 0x1d, 0xee, 0x10, 0x0f, 0x75, 0xe2, 0x5c, 0x81, 0xb1, 0x54, 0x45,
+	0xbc, 0xe6, 0x3f, 0xc7, 0xf9, 0x8b, 0x70, 0x46, 0xf3, 0x77, 0x04, 0xa1, 0x07, 0x26, 0x59, 0xf8,
+	0xa7, 0x7d, 0x1c, 0x8d, 0xe0, 0xd8, 0x28, 0xf3, 0x66, 0x55, 0xae, 0x0b, 0x39, 0x68, 0xee, 0x0c,
+	0x7b, 0xc6, 0x70, 0x56, 0xa5, 0x08, 0xcc, 0xe8, 0xf8, 0x7d, 0x1a, 0x61, 0xd4, 0x7d, 0x33, 0xa9,
+	0xf1, 0x71, 0xe1, 0x52, 0x95, 0x98, 0x73, 0x6f, 0x3a, 0xeb, 0xd7, 0x1c, 0xf8, 0x14, 0x4c, 0x6d,
+	0xf9, 0xbe, 0x28, 0x43, 0x63, 0x08, 0xe7, 0xd3, 0x8c, 0x5b, 0xbe, 0xdf, 0x18, 0xc6, 0x4a, 0xac,
+	0x64, 0x83, 0xcc, 0x71, 0x71, 0xf9, 0xcc, 0xa6, 0xc3, 0x58, 0x94, 0x8d, 0xe5, 0xf4, 0x80, 0xf3,
+	0xef, 0xe1, 0x2e, 0xa1, 0xf8, 0xff, 0xf3, 0x43, 0xce, 0x5f, 0x70, 0xdf, 0x95, 0xfc, 0x62, 0x4e,
+	0x9d, 0x6c, 0x0c, 0xd9, 0xfa, 0xea, 0xc7, 0xe6, 0xbe, 0xa5, 0x5a, 0x15, 0xf1, 0xa2, 0x1d, 0xb4,
+	0x8d, 0x07, 0xb3, 0x1e, 0x73, 0x9c, 0x29, 0x34, 0xc0, 0xf1, 0xc6, 0xb0, 0x8e, 0x29, 0x3c, 0x6f,
+	0x32, 0xd4, 0xb1, 0x5e, 0x1b, 0xf3, 0x16, 0xc4, 0xdc, 0xb5, 0xdc, 0x29, 0x45, 0xdc, 0xc2, 0x54,
+	0xb3, 0xee, 0xa0, 0x38, 0xcb, 0xba, 0x83, 0xe2, 0x1c, 0x56, 0x8e, 0xe4, 0xb1, 0xb6, 0x11, 0xf7,
+	0xfa, 0xa3, 0x03, 0xce, 0xed, 0xf7, 0x0f, 0x63, 0x2f, 0x0a, 0x0e, 0xf1, 0x3d, 0xfc, 0xbc, 0x11,
+	0xa1, 0x30, 0x46, 0x1e, 0x3b, 0x9c, 0xe0, 0x8a, 0xb1, 0xab, 0xa7, 0x83, 0x86, 0x4a, 0xd1, 0x7d,
+	0x59, 0x88, 0x94, 0xae, 0x72, 0xe9, 0xab, 0xb0, 0xa8, 0x2b, 0xa5, 0xe2, 0x1e, 0xce, 0xb9, 0xe3,
+	0x8d, 0x62, 0x7e, 0x7d, 0x05, 0x8a, 0xf7, 0x11, 0xf5, 0xda, 0x29, 0x3f, 0x31, 0x5c, 0x4d, 0xab,
+	0x8d, 0xc1, 0xca, 0xd3, 0xda, 0x2b, 0xa2, 0xf2, 0xe6, 0xde, 0x73, 0x16, 0x2a, 0xd4, 0x5f, 0x38,
+	0xa0, 0xa8, 0xb3, 0x69, 0x0c, 0x6f, 0x0e, 0x70, 0x48, 0x33, 0xf2, 0x63, 0xb0, 0x55, 0xde, 0x12,
+	0x25, 0xe5, 0xaf, 0x72, 0xf9, 0x35, 0xa8, 0xe7, 0x0f, 0xe6, 0xf8, 0xc3, 0x33, 0x6e, 0xa6, 0x45,
+	0x38, 0xda, 0x03, 0xc7, 0x6b, 0xd1, 0xa8, 0x97, 0x99, 0x53, 0xbc, 0xc9, 0x3a, 0xfa, 0x12, 0x91,
+	0x62, 0xb3, 0x5c, 0x6c, 0xda, 0xe5, 0x47, 0xb8, 0xc7, 0x20, 0x36, 0xf6, 0x5f, 0x02, 0xa8, 0xd6,
+	0xb0, 0xbc, 0x3c, 0x34, 0x86, 0x31, 0x5c, 0xce, 0xae, 0x27, 0x0d, 0x29, 0xa1, 0x95, 0x97, 0x44,
+	0xd8, 0xb6, 0x10, 0x19, 0xbd, 0x61, 0x2c, 0xf0, 0x01, 0x38, 0x93, 0x2c, 0xf0, 0xb7, 0xac, 0x5e,
+	0xe2, 0xea, 0xb3, 0xee, 0x4c, 0x46, 0x9d, 0xe9, 0x3e, 0x01, 0xd3, 0xb5, 0x36, 0xf6, 0x8e, 0xf6,
+	0xb1, 0x17, 0x61, 0x7a, 0x1b, 0xe7, 0xdf, 0x8c, 0x8c, 0xb9, 0x6d, 0xf6, 0xd1, 0x4a, 0x33, 0x5c,
+	0x69, 0x12, 0xf2, 0x0d, 0xe6, 0x08, 0x8f, 0x60, 0x04, 0xa6, 0xea, 0x98, 0x6e, 0xf5, 0x69, 0xfb,
+	0x06, 0xa2, 0xc8, 0x3c, 0x23, 0x53, 0x80, 0xf5, 0x8c, 0x34, 0x70, 0x29, 0xe0, 0x72, 0x81, 0x45,
+	0xf7, 0x1c, 0x13, 0x68, 0xa3, 0xd0, 0x8f, 0xdb, 0xe8, 0x08, 0x57, 0x50, 0x9f, 0xb6, 0x37, 0x7c,
+	0x44, 0x11, 0x4b, 0xe9, 0x19, 0x00, 0x07, 0x38, 0x0a, 0x9a, 0x23, 0xd6, 0x1b, 0x1a, 0x9b, 0x7b,
+	0xd2, 0xae, 0x14, 0x97, 0xf2, 0x60, 0x29, 0xb8, 0xca, 0x05, 0x97, 0xdc, 0x79, 0x53, 0x70, 0xc0,
+	0x23, 0x37, 0x98, 0x2e, 0x93, 0xfc, 0x02, 0x9c, 0xaa, 0x63, 0x2a, 0xba, 0xf3, 0x44, 0x97, 0x33,
+	0x89, 0x24, 0x90, 0x75, 0xdc, 0x32, 0x11, 0xaf, 0xa5, 0xad, 0xd2, 0xfd, 0xde, 0x01, 0x50, 0x74,
+	0x16, 0xb3, 0x47, 0xfc, 0x86, 0x6b, 0xe3, 0x89, 0xa5, 0x71, 0x65, 0xe3, 0xf2, 0xab, 0xc2, 0xa4,
+	0x97, 0x0d, 0xee, 0xe5, 0x8a, 0xeb, 0x5a, 0xbd, 0x44, 0xbc, 0xcb, 0x86, 0xf8, 0x62, 0xa6, 0xbe,
+	0x75, 0x40, 0x31, 0xd9, 0xdf, 0x6f, 0x63, 0x51, 0x95, 0xd5, 0x4c, 0xce, 0x26, 0x6c, 0xdd, 0x31,
+	0x2c, 0x51, 0xd2, 0xd1, 0xfb, 0xdc, 0xd1, 0x25, 0x77, 0xc9, 0x74, 0x24, 0x9f, 0x04, 0x1b, 0x47,
+	0x38, 0x29, 0xd1, 0x4f, 0x0e, 0x98, 0x35, 0xd2, 0x56, 0x86, 0xae, 0xd8, 0xb2, 0xb7, 0x79, 0x7a,
+	0xef, 0xd5, 0x81, 0xd2, 0xd6, 0x35, 0x6e, 0x6b, 0xdd, 0x5d, 0xcb, 0x29, 0xd4, 0xb8, 0xbb, 0x17,
+	0x0e, 0x80, 0xa9, 0x13, 0x4f, 0x79, 0x1b, 0x2f, 0x83, 0x81, 0x5b, 0x07, 0xd0, 0x16, 0x26, 0x7d,
+	0xad, 0x73, 0x5f, 0xab, 0xee, 0xc5, 0x6c, 0xb9, 0x04, 0x6e, 0x38, 0xfa, 0xce, 0x01, 0x45, 0x73,
+	0xfc, 0xd9, 0xc6, 0xb0, 0x6a, 0xab, 0x81, 0x86, 0xad, 0xa3, 0x67, 0x89, 0x92, 0x76, 0x3e, 0xe0,
+	0x76, 0x2e, 0xbb, 0x2b, 0x39, 0x65, 0x4a, 0x5c, 0x31, 0x43, 0x3e, 0x98, 0xbc, 0x15, 0x74, 0x3a,
+	0xdb, 0x1d, 0xe2, 0x1d, 0x99, 0x97, 0x39, 0xdd, 0x6c, 0xbd, 0xcc, 0xa5, 0x50, 0xdb, 0x5e, 0x78,
+	0xc8, 0xa0, 0xb8, 0xd2, 0x0c, 0x3a, 0x7c, 0x0f, 0xfe, 0xc6, 0x01, 0xb3, 0x35, 0xd2, 0xed, 0x06,
+	0x94, 0x62, 0x9f, 0x77, 0x93, 0x6b, 0xc9, 0x98, 0x26, 0xb6, 0x08, 0xeb, 0x34, 0xb1, 0x07, 0x4a,
+	0x1f, 0x8b, 0xdc, 0x87, 0x3c, 0xf0, 0xa5, 0x0f, 0xbd, 0x7c, 0xae, 0xff, 0xea, 0x80, 0xc2, 0x96,
+	0xdf, 0x0d, 0x42, 0xf5, 0x7c, 0xbd, 0x25, 0xee, 0xe5, 0x35, 0x12, 0x36, 0x83, 0xd6, 0xeb, 0xdf,
+	0xcb, 0x45, 0xbc, 0x16, 0x3d, 0x06, 0xf7, 0xc1, 0x29, 0xf6, 0x1e, 0x4d, 0xa8, 0x96, 0xb3, 0x4f,
+	0xd5, 0x54, 0x2f, 0xcb, 0x0e, 0x69, 0x23, 0xbd, 0xfe, 0xef, 0x09, 0x50, 0xa8, 0xb5, 0x51, 0xe2,
+	0xf6, 0x93, 0xb7, 0xf6, 0xd8, 0x3e, 0x06, 0xef, 0x82, 0x42, 0x1d, 0x53, 0x4e, 0xff, 0x71, 0xd8,
+	0x24, 0xe6, 0xe8, 0xeb, 0x66, 0xeb, 0xe8, 0xa7, 0x50, 0x4d, 0xb7, 0x05, 0x4e, 0xd6, 0x31, 0x15,
+	0x13, 0xc9, 0xb8, 0x3b, 0x18, 0x93, 0x68, 0xde, 0x82, 0x68, 0x8a, 0x1d, 0x30, 0xa9, 0x28, 0x62,
+	0xf3, 0x78, 0x11, 0x91, 0x28, 0x6c, 0x61, 0xeb, 0x6d, 0x5c, 0x74, 0x49, 0x31, 0xdd, 0xe0, 0x4c,
+	0x3b, 0x18, 0xf9, 0x38, 0x32, 0xef, 0xf4, 0xa2, 0xcd, 0xca, 0xa2, 0x20, 0xcd, 0x52, 0x07, 0x80,
+	0xf9, 0x41, 0x1d, 0xc4, 0x5e, 0xef, 0xa6, 0xa2, 0x68, 0x54, 0x3c, 0x0b, 0x56, 0x2c, 0x53, 0x1b,
+	0xf1, 0x27, 0x80, 0x51, 0x1b, 0xe3, 0xf5, 0x3f, 0x6f, 0x41, 0x52, 0xf3, 0xab, 0x50, 0xeb, 0x47,
+	0x11, 0x0e, 0x65, 0x89, 0x8d, 0x03, 0x3d, 0x8d, 0x58, 0xff, 0x4b, 0x30, 0x03, 0xd2, 0xa4, 0x77,
+	0x10, 0xc5, 0xb1, 0xaa, 0x94, 0x41, 0x9a, 0x46, 0xac, 0xa4, 0x66, 0x80, 0x26, 0x7d, 0xc4, 0x2e,
+	0x3e, 0x28, 0x08, 0x19, 0xc0, 0xef, 0xa2, 0xe6, 0xfd, 0xde, 0xc4, 0xac, 0xf7, 0xfb, 0x6c, 0x88,
+	0xa2, 0xbe, 0xe6, 0x40, 0x04, 0x4e, 0x6b, 0x14, 0x47, 0x82, 0xfe, 0x92, 0xb5, 0x2f, 0x8e, 0x0c,
+	0x81, 0xd5, 0x97, 0x07, 0x25, 0x12, 0xdb, 0xbf, 0x39, 0x60, 0xda, 0x23, 0xdd, 0x54, 0xfc, 0xb6,
+	0x5a, 0x74, 0xbb, 0x6c, 0x95, 0xed, 0x3a, 0x0f, 0x6f, 0xb4, 0x02, 0xda, 0xee, 0x1f, 0x96, 0x3d,
+	0xd2, 0xd5, 0xd7, 0x3e, 0x1f, 0x37, 0x03, 0xfd, 0x81, 0xc3, 0x56, 0x10, 0xca, 0xbf, 0xd9, 0x3c,
+	0xd2, 0xa9, 0x24, 0xff, 0xac, 0x7d, 0x24, 0x7f, 0x0e, 0xaa, 0x3f, 0x4f, 0xbc, 0xd3, 0x78, 0xf0,
+	0xe0, 0x97, 0x09, 0x20, 0x2f, 0x96, 0xe5, 0x83, 0xea, 0x1f, 0xfa, 0xe3, 0xf1, 0x41, 0xf5, 0xaf,
+	0x89, 0xb9, 0xe4, 0xe3, 0x71, 0x7d, 0x77, 0xfb, 0x2e, 0xa6, 0x88, 0x1d, 0x24, 0xff, 0x4c, 0x4c,
+	0x49, 0x60, 0x73, 0xf3, 0xa0, 0x7a, 0x78, 0x82, 0xab, 0x7c, 0xf8, 0xdf, 0x00, 0x66, 0x31, 0xd5,
+	0xc6, 0x20, 0x14, 0x00, 0x00,
 }
@@ -31,18 +31,29 @@ type TrustedServiceClient interface {
 	ServiceReady(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServiceReadyResponse, error)
 	PoolSetPrice(ctx context.Context, in *SetPriceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	PoolGasPrice(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GasPriceResponse, error)
+	// PendingNonce, PoolContentFrom and TxGet answer owner-signed queries only,
+	// encrypted to the reply key of the owner.
 	PendingNonce(ctx context.Context, in *PendingNonceRequest, opts ...grpc.CallOption) (*PendingNonceResponse, error)
 	PoolStat(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PoolStatResponse, error)
 	PoolContent(ctx context.Context, in *PoolContentRequest, opts ...grpc.CallOption) (*PoolContentResponse, error)
 	PoolContentFrom(ctx context.Context, in *PoolContentRequest, opts ...grpc.CallOption) (*PoolContentResponse, error)
 	PoolPending(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PoolPendingResponse, error)
 	PoolLocals(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PoolLocalsResponse, error)
+	// PoolQuery returns a page of the pool content, PoolQueryStream all pages
+	// from one snapshot of the pool.
+	PoolQuery(ctx context.Context, in *PoolQueryRequest, opts ...grpc.CallOption) (*PoolQueryResponse, error)
+	PoolQueryStream(ctx context.Context, in *PoolQueryRequest, opts ...grpc.CallOption) (TrustedService_PoolQueryStreamClient, error)
 	AddLocalsTx(ctx context.Context, in *AddTxsRequest, opts ...grpc.CallOption) (*AddTxsResponse, error)
 	AddRemoteTx(ctx context.Context, in *AddTxsRequest, opts ...grpc.CallOption) (*AddTxsResponse, error)
 	TxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (*TxStatusResponse, error)
 	TxGet(ctx context.Context, in *TxGetRequest, opts ...grpc.CallOption) (*TxGetResponse, error)
 	TxHas(ctx context.Context, in *TxHasRequest, opts ...grpc.CallOption) (*TxHasResponse, error)
 	SubscribeNewTransaction(ctx context.Context, in *SubscribeNewTxRequest, opts ...grpc.CallOption) (TrustedService_SubscribeNewTransactionClient, error)
+	// WatchTransactions streams the status changes of the given transactions
+	// until all are final or the timeout passes.
+	WatchTransactions(ctx context.Context, in *WatchTransactionsRequest, opts ...grpc.CallOption) (TrustedService_WatchTransactionsClient, error)
+	// SubscribeTxEvents streams why transactions change state or leave the pool.
+	SubscribeTxEvents(ctx context.Context, in *SubscribeTxEventsRequest, opts ...grpc.CallOption) (TrustedService_SubscribeTxEventsClient, error)
 	Crypt(ctx context.Context, in *CryptRequest, opts ...grpc.CallOption) (*CryptResponse, error)
 	AddLocalTrustedTxs(ctx context.Context, in *AddTrustedTxsRequest, opts ...grpc.CallOption) (*AddTrustedTxsResponse, error)
 	AddRemoteTrustedTxs(ctx context.Context, in *AddTrustedTxsRequest, opts ...grpc.CallOption) (*AddTrustedTxsResponse, error)
@@ -149,6 +160,47 @@ func (c *trustedServiceClient) PoolLocals(ctx context.Context, in *emptypb.Empty
 	return out, nil
 }
 
+func (c *trustedServiceClient) PoolQuery(ctx context.Context, in *PoolQueryRequest, opts ...grpc.CallOption) (*PoolQueryResponse, error) {
+	out := new(PoolQueryResponse)
+	err := c.cc.Invoke(ctx, "/trusted.v1.TrustedService/PoolQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trustedServiceClient) PoolQueryStream(ctx context.Context, in *PoolQueryRequest, opts ...grpc.CallOption) (TrustedService_PoolQueryStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TrustedService_ServiceDesc.Streams[0], "/trusted.v1.TrustedService/PoolQueryStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &trustedServicePoolQueryStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TrustedService_PoolQueryStreamClient interface {
+	Recv() (*PoolQueryResponse, error)
+	grpc.ClientStream
+}
+
+type trustedServicePoolQueryStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *trustedServicePoolQueryStreamClient) Recv() (*PoolQueryResponse, error) {
+	m := new(PoolQueryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *trustedServiceClient) AddLocalsTx(ctx context.Context, in *AddTxsRequest, opts ...grpc.CallOption) (*AddTxsResponse, error) {
 	out := new(AddTxsResponse)
 	err := c.cc.Invoke(ctx, "/trusted.v1.TrustedService/AddLocalsTx", in, out, opts...)
@@ -195,7 +247,7 @@ func (c *trustedServiceClient) TxHas(ctx context.Context, in *TxHasRequest, opts
 }
 
 func (c *trustedServiceClient) SubscribeNewTransaction(ctx context.Context, in *SubscribeNewTxRequest, opts ...grpc.CallOption) (TrustedService_SubscribeNewTransactionClient, error) {
-	stream, err := c.cc.NewStream(ctx, &TrustedService_ServiceDesc.Streams[0], "/trusted.v1.TrustedService/SubscribeNewTransaction", opts...)
+	stream, err := c.cc.NewStream(ctx, &TrustedService_ServiceDesc.Streams[1], "/trusted.v1.TrustedService/SubscribeNewTransaction", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -226,6 +278,70 @@ func (x *trustedServiceSubscribeNewTransactionClient) Recv() (*SubscribeNewTxRes
 	return m, nil
 }
 
+func (c *trustedServiceClient) WatchTransactions(ctx context.Context, in *WatchTransactionsRequest, opts ...grpc.CallOption) (TrustedService_WatchTransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TrustedService_ServiceDesc.Streams[2], "/trusted.v1.TrustedService/WatchTransactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &trustedServiceWatchTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TrustedService_WatchTransactionsClient interface {
+	Recv() (*WatchTransactionsResponse, error)
+	grpc.ClientStream
+}
+
+type trustedServiceWatchTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *trustedServiceWatchTransactionsClient) Recv() (*WatchTransactionsResponse, error) {
+	m := new(WatchTransactionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *trustedServiceClient) SubscribeTxEvents(ctx context.Context, in *SubscribeTxEventsRequest, opts ...grpc.CallOption) (TrustedService_SubscribeTxEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TrustedService_ServiceDesc.Streams[3], "/trusted.v1.TrustedService/SubscribeTxEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &trustedServiceSubscribeTxEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TrustedService_SubscribeTxEventsClient interface {
+	Recv() (*SubscribeTxEventsResponse, error)
+	grpc.ClientStream
+}
+
+type trustedServiceSubscribeTxEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *trustedServiceSubscribeTxEventsClient) Recv() (*SubscribeTxEventsResponse, error) {
+	m := new(SubscribeTxEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *trustedServiceClient) Crypt(ctx context.Context, in *CryptRequest, opts ...grpc.CallOption) (*CryptResponse, error) {
 	out := new(CryptResponse)
 	err := c.cc.Invoke(ctx, "/trusted.v1.TrustedService/Crypt", in, out, opts...)
@@ -364,18 +480,29 @@ type TrustedServiceServer interface {
 	ServiceReady(context.Context, *emptypb.Empty) (*ServiceReadyResponse, error)
 	PoolSetPrice(context.Context, *SetPriceRequest) (*emptypb.Empty, error)
 	PoolGasPrice(context.Context, *emptypb.Empty) (*GasPriceResponse, error)
+	// PendingNonce, PoolContentFrom and TxGet answer owner-signed queries only,
+	// encrypted to the reply key of the owner.
 	PendingNonce(context.Context, *PendingNonceRequest) (*PendingNonceResponse, error)
 	PoolStat(context.Context, *emptypb.Empty) (*PoolStatResponse, error)
 	PoolContent(context.Context, *PoolContentRequest) (*PoolContentResponse, error)
 	PoolContentFrom(context.Context, *PoolContentRequest) (*PoolContentResponse, error)
 	PoolPending(context.Context, *emptypb.Empty) (*PoolPendingResponse, error)
 	PoolLocals(context.Context, *emptypb.Empty) (*PoolLocalsResponse, error)
+	// PoolQuery returns a page of the pool content, PoolQueryStream all pages
+	// from one snapshot of the pool.
+	PoolQuery(context.Context, *PoolQueryRequest) (*PoolQueryResponse, error)
+	PoolQueryStream(*PoolQueryRequest, TrustedService_PoolQueryStreamServer) error
 	AddLocalsTx(context.Context, *AddTxsRequest) (*AddTxsResponse, error)
 	AddRemoteTx(context.Context, *AddTxsRequest) (*AddTxsResponse, error)
 	TxStatus(context.Context, *TxStatusRequest) (*TxStatusResponse, error)
 	TxGet(context.Context, *TxGetRequest) (*TxGetResponse, error)
 	TxHas(context.Context, *TxHasRequest) (*TxHasResponse, error)
 	SubscribeNewTransaction(*SubscribeNewTxRequest, TrustedService_SubscribeNewTransactionServer) error
+	// WatchTransactions streams the status changes of the given transactions
+	// until all are final or the timeout passes.
+	WatchTransactions(*WatchTransactionsRequest, TrustedService_WatchTransactionsServer) error
+	// SubscribeTxEvents streams why transactions change state or leave the pool.
+	SubscribeTxEvents(*SubscribeTxEventsRequest, TrustedService_SubscribeTxEventsServer) error
 	Crypt(context.Context, *CryptRequest) (*CryptResponse, error)
 	AddLocalTrustedTxs(context.Context, *AddTrustedTxsRequest) (*AddTrustedTxsResponse, error)
 	AddRemoteTrustedTxs(context.Context, *AddTrustedTxsRequest) (*AddTrustedTxsResponse, error)
@@ -425,6 +552,12 @@ func (UnimplementedTrustedServiceServer) PoolPending(context.Context, *emptypb.E
 func (UnimplementedTrustedServiceServer) PoolLocals(context.Context, *emptypb.Empty) (*PoolLocalsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolLocals not implemented")
 }
+func (UnimplementedTrustedServiceServer) PoolQuery(context.Context, *PoolQueryRequest) (*PoolQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolQuery not implemented")
+}
+func (UnimplementedTrustedServiceServer) PoolQueryStream(*PoolQueryRequest, TrustedService_PoolQueryStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method PoolQueryStream not implemented")
+}
 func (UnimplementedTrustedServiceServer) AddLocalsTx(context.Context, *AddTxsRequest) (*AddTxsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddLocalsTx not implemented")
 }
@@ -443,6 +576,12 @@ func (UnimplementedTrustedServiceServer) TxHas(context.Context, *TxHasRequest) (
 func (UnimplementedTrustedServiceServer) SubscribeNewTransaction(*SubscribeNewTxRequest, TrustedService_SubscribeNewTransactionServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeNewTransaction not implemented")
 }
+func (UnimplementedTrustedServiceServer) WatchTransactions(*WatchTransactionsRequest, TrustedService_WatchTransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTransactions not implemented")
+}
+func (UnimplementedTrustedServiceServer) SubscribeTxEvents(*SubscribeTxEventsRequest, TrustedService_SubscribeTxEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTxEvents not implemented")
+}
 func (UnimplementedTrustedServiceServer) Crypt(context.Context, *CryptRequest) (*CryptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Crypt not implemented")
 }
@@ -660,6 +799,45 @@ func _TrustedService_PoolLocals_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TrustedService_PoolQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrustedServiceServer).PoolQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trusted.v1.TrustedService/PoolQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrustedServiceServer).PoolQuery(ctx, req.(*PoolQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrustedService_PoolQueryStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PoolQueryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TrustedServiceServer).PoolQueryStream(m, &trustedServicePoolQueryStreamServer{stream})
+}
+
+type TrustedService_PoolQueryStreamServer interface {
+	Send(*PoolQueryResponse) error
+	grpc.ServerStream
+}
+
+type trustedServicePoolQueryStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *trustedServicePoolQueryStreamServer) Send(m *PoolQueryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _TrustedService_AddLocalsTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddTxsRequest)
 	if err := dec(in); err != nil {
@@ -771,6 +949,48 @@ func (x *trustedServiceSubscribeNewTransactionServer) Send(m *SubscribeNewTxResp
 	return x.ServerStream.SendMsg(m)
 }
 
+func _TrustedService_WatchTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TrustedServiceServer).WatchTransactions(m, &trustedServiceWatchTransactionsServer{stream})
+}
+
+type TrustedService_WatchTransactionsServer interface {
+	Send(*WatchTransactionsResponse) error
+	grpc.ServerStream
+}
+
+type trustedServiceWatchTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *trustedServiceWatchTransactionsServer) Send(m *WatchTransactionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TrustedService_SubscribeTxEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTxEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TrustedServiceServer).SubscribeTxEvents(m, &trustedServiceSubscribeTxEventsServer{stream})
+}
+
+type TrustedService_SubscribeTxEventsServer interface {
+	Send(*SubscribeTxEventsResponse) error
+	grpc.ServerStream
+}
+
+type trustedServiceSubscribeTxEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *trustedServiceSubscribeTxEventsServer) Send(m *SubscribeTxEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _TrustedService_Crypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CryptRequest)
 	if err := dec(in); err != nil {
@@ -1066,6 +1286,10 @@ var TrustedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PoolLocals",
 			Handler:    _TrustedService_PoolLocals_Handler,
 		},
+		{
+			MethodName: "PoolQuery",
+			Handler:    _TrustedService_PoolQuery_Handler,
+		},
 		{
 			MethodName: "AddLocalsTx",
 			Handler:    _TrustedService_AddLocalsTx_Handler,
@@ -1144,12 +1368,155 @@ var TrustedService_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PoolQueryStream",
+			Handler:       _TrustedService_PoolQueryStream_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeNewTransaction",
 			Handler:       _TrustedService_SubscribeNewTransaction_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchTransactions",
+			Handler:       _TrustedService_WatchTransactions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeTxEvents",
+			Handler:       _TrustedService_SubscribeTxEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "trusted/v1/service.proto",
+}
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	// PoolConfig returns the configuration the pool currently runs with.
+	PoolConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PoolConfigResponse, error)
+	// SetPoolConfig applies a pool configuration at runtime and returns the
+	// sanitized result.
+	SetPoolConfig(ctx context.Context, in *SetPoolConfigRequest, opts ...grpc.CallOption) (*PoolConfigResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) PoolConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PoolConfigResponse, error) {
+	out := new(PoolConfigResponse)
+	err := c.cc.Invoke(ctx, "/trusted.v1.AdminService/PoolConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetPoolConfig(ctx context.Context, in *SetPoolConfigRequest, opts ...grpc.CallOption) (*PoolConfigResponse, error) {
+	out := new(PoolConfigResponse)
+	err := c.cc.Invoke(ctx, "/trusted.v1.AdminService/SetPoolConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility
+type AdminServiceServer interface {
+	// PoolConfig returns the configuration the pool currently runs with.
+	PoolConfig(context.Context, *emptypb.Empty) (*PoolConfigResponse, error)
+	// SetPoolConfig applies a pool configuration at runtime and returns the
+	// sanitized result.
+	SetPoolConfig(context.Context, *SetPoolConfigRequest) (*PoolConfigResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (UnimplementedAdminServiceServer) PoolConfig(context.Context, *emptypb.Empty) (*PoolConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) SetPoolConfig(context.Context, *SetPoolConfigRequest) (*PoolConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPoolConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_PoolConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PoolConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trusted.v1.AdminService/PoolConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PoolConfig(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetPoolConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPoolConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetPoolConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trusted.v1.AdminService/SetPoolConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetPoolConfig(ctx, req.(*SetPoolConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "trusted.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PoolConfig",
+			Handler:    _AdminService_PoolConfig_Handler,
+		},
+		{
+			MethodName: "SetPoolConfig",
+			Handler:    _AdminService_SetPoolConfig_Handler,
+		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "trusted/v1/service.proto",
 }
 
@@ -1158,12 +1525,20 @@ var TrustedService_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ChainServiceClient interface {
 	ServiceReady(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServiceReadyResponse, error)
+	// GetChainInfo is exchanged at connect time to negotiate chain and features.
+	GetChainInfo(ctx context.Context, in *ChainInfoRequest, opts ...grpc.CallOption) (*ChainInfoResponse, error)
 	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	// GetBlocks returns a block and up to count-1 of its ancestors, newest first.
+	GetBlocks(ctx context.Context, in *BlockRangeRequest, opts ...grpc.CallOption) (*BlocksResponse, error)
+	// GetHeader returns a header without its body.
+	GetHeader(ctx context.Context, in *HeaderRequest, opts ...grpc.CallOption) (*HeaderResponse, error)
 	GetBalance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error)
 	GetNonce(ctx context.Context, in *NonceRequest, opts ...grpc.CallOption) (*NonceResponse, error)
 	CurrentBlock(ctx context.Context, in *CurrentBlockRequest, opts ...grpc.CallOption) (*CurrentBlockResponse, error)
 	LatestHeader(ctx context.Context, in *LatestHeaderRequest, opts ...grpc.CallOption) (*LatestHeaderResponse, error)
 	ChainHeadEvent(ctx context.Context, in *ChainHeadEventRequest, opts ...grpc.CallOption) (ChainService_ChainHeadEventClient, error)
+	// header-only head stream, bodies are fetched with GetBlock when needed.
+	ChainHeaderEvent(ctx context.Context, in *ChainHeaderEventRequest, opts ...grpc.CallOption) (ChainService_ChainHeaderEventClient, error)
 }
 
 type chainServiceClient struct {
@@ -1183,6 +1558,15 @@ func (c *chainServiceClient) ServiceReady(ctx context.Context, in *emptypb.Empty
 	return out, nil
 }
 
+func (c *chainServiceClient) GetChainInfo(ctx context.Context, in *ChainInfoRequest, opts ...grpc.CallOption) (*ChainInfoResponse, error) {
+	out := new(ChainInfoResponse)
+	err := c.cc.Invoke(ctx, "/trusted.v1.ChainService/GetChainInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chainServiceClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
 	out := new(BlockResponse)
 	err := c.cc.Invoke(ctx, "/trusted.v1.ChainService/GetBlock", in, out, opts...)
@@ -1192,6 +1576,24 @@ func (c *chainServiceClient) GetBlock(ctx context.Context, in *BlockRequest, opt
 	return out, nil
 }
 
+func (c *chainServiceClient) GetBlocks(ctx context.Context, in *BlockRangeRequest, opts ...grpc.CallOption) (*BlocksResponse, error) {
+	out := new(BlocksResponse)
+	err := c.cc.Invoke(ctx, "/trusted.v1.ChainService/GetBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainServiceClient) GetHeader(ctx context.Context, in *HeaderRequest, opts ...grpc.CallOption) (*HeaderResponse, error) {
+	out := new(HeaderResponse)
+	err := c.cc.Invoke(ctx, "/trusted.v1.ChainService/GetHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chainServiceClient) GetBalance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error) {
 	out := new(BalanceResponse)
 	err := c.cc.Invoke(ctx, "/trusted.v1.ChainService/GetBalance", in, out, opts...)
@@ -1260,17 +1662,57 @@ func (x *chainServiceChainHeadEventClient) Recv() (*ChainHeadEventResponse, erro
 	return m, nil
 }
 
+func (c *chainServiceClient) ChainHeaderEvent(ctx context.Context, in *ChainHeaderEventRequest, opts ...grpc.CallOption) (ChainService_ChainHeaderEventClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChainService_ServiceDesc.Streams[1], "/trusted.v1.ChainService/ChainHeaderEvent", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chainServiceChainHeaderEventClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChainService_ChainHeaderEventClient interface {
+	Recv() (*ChainHeaderEventResponse, error)
+	grpc.ClientStream
+}
+
+type chainServiceChainHeaderEventClient struct {
+	grpc.ClientStream
+}
+
+func (x *chainServiceChainHeaderEventClient) Recv() (*ChainHeaderEventResponse, error) {
+	m := new(ChainHeaderEventResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ChainServiceServer is the server API for ChainService service.
 // All implementations must embed UnimplementedChainServiceServer
 // for forward compatibility
 type ChainServiceServer interface {
 	ServiceReady(context.Context, *emptypb.Empty) (*ServiceReadyResponse, error)
+	// GetChainInfo is exchanged at connect time to negotiate chain and features.
+	GetChainInfo(context.Context, *ChainInfoRequest) (*ChainInfoResponse, error)
 	GetBlock(context.Context, *BlockRequest) (*BlockResponse, error)
+	// GetBlocks returns a block and up to count-1 of its ancestors, newest first.
+	GetBlocks(context.Context, *BlockRangeRequest) (*BlocksResponse, error)
+	// GetHeader returns a header without its body.
+	GetHeader(context.Context, *HeaderRequest) (*HeaderResponse, error)
 	GetBalance(context.Context, *BalanceRequest) (*BalanceResponse, error)
 	GetNonce(context.Context, *NonceRequest) (*NonceResponse, error)
 	CurrentBlock(context.Context, *CurrentBlockRequest) (*CurrentBlockResponse, error)
 	LatestHeader(context.Context, *LatestHeaderRequest) (*LatestHeaderResponse, error)
 	ChainHeadEvent(*ChainHeadEventRequest, ChainService_ChainHeadEventServer) error
+	// header-only head stream, bodies are fetched with GetBlock when needed.
+	ChainHeaderEvent(*ChainHeaderEventRequest, ChainService_ChainHeaderEventServer) error
 	mustEmbedUnimplementedChainServiceServer()
 }
 
@@ -1281,9 +1723,18 @@ type UnimplementedChainServiceServer struct {
 func (UnimplementedChainServiceServer) ServiceReady(context.Context, *emptypb.Empty) (*ServiceReadyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ServiceReady not implemented")
 }
+func (UnimplementedChainServiceServer) GetChainInfo(context.Context, *ChainInfoRequest) (*ChainInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainInfo not implemented")
+}
 func (UnimplementedChainServiceServer) GetBlock(context.Context, *BlockRequest) (*BlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
 }
+func (UnimplementedChainServiceServer) GetBlocks(context.Context, *BlockRangeRequest) (*BlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlocks not implemented")
+}
+func (UnimplementedChainServiceServer) GetHeader(context.Context, *HeaderRequest) (*HeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHeader not implemented")
+}
 func (UnimplementedChainServiceServer) GetBalance(context.Context, *BalanceRequest) (*BalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
 }
@@ -1299,6 +1750,9 @@ func (UnimplementedChainServiceServer) LatestHeader(context.Context, *LatestHead
 func (UnimplementedChainServiceServer) ChainHeadEvent(*ChainHeadEventRequest, ChainService_ChainHeadEventServer) error {
 	return status.Errorf(codes.Unimplemented, "method ChainHeadEvent not implemented")
 }
+func (UnimplementedChainServiceServer) ChainHeaderEvent(*ChainHeaderEventRequest, ChainService_ChainHeaderEventServer) error {
+	return status.Errorf(codes.Unimplemented, "method ChainHeaderEvent not implemented")
+}
 func (UnimplementedChainServiceServer) mustEmbedUnimplementedChainServiceServer() {}
 
 // UnsafeChainServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1330,6 +1784,24 @@ func _ChainService_ServiceReady_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChainService_GetChainInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainServiceServer).GetChainInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trusted.v1.ChainService/GetChainInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainServiceServer).GetChainInfo(ctx, req.(*ChainInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChainService_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlockRequest)
 	if err := dec(in); err != nil {
@@ -1348,6 +1820,42 @@ func _ChainService_GetBlock_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChainService_GetBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainServiceServer).GetBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trusted.v1.ChainService/GetBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainServiceServer).GetBlocks(ctx, req.(*BlockRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainService_GetHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainServiceServer).GetHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trusted.v1.ChainService/GetHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainServiceServer).GetHeader(ctx, req.(*HeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChainService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BalanceRequest)
 	if err := dec(in); err != nil {
@@ -1441,6 +1949,27 @@ func (x *chainServiceChainHeadEventServer) Send(m *ChainHeadEventResponse) error
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ChainService_ChainHeaderEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChainHeaderEventRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChainServiceServer).ChainHeaderEvent(m, &chainServiceChainHeaderEventServer{stream})
+}
+
+type ChainService_ChainHeaderEventServer interface {
+	Send(*ChainHeaderEventResponse) error
+	grpc.ServerStream
+}
+
+type chainServiceChainHeaderEventServer struct {
+	grpc.ServerStream
+}
+
+func (x *chainServiceChainHeaderEventServer) Send(m *ChainHeaderEventResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // ChainService_ServiceDesc is the grpc.ServiceDesc for ChainService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1452,10 +1981,22 @@ var ChainService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ServiceReady",
 			Handler:    _ChainService_ServiceReady_Handler,
 		},
+		{
+			MethodName: "GetChainInfo",
+			Handler:    _ChainService_GetChainInfo_Handler,
+		},
 		{
 			MethodName: "GetBlock",
 			Handler:    _ChainService_GetBlock_Handler,
 		},
+		{
+			MethodName: "GetBlocks",
+			Handler:    _ChainService_GetBlocks_Handler,
+		},
+		{
+			MethodName: "GetHeader",
+			Handler:    _ChainService_GetHeader_Handler,
+		},
 		{
 			MethodName: "GetBalance",
 			Handler:    _ChainService_GetBalance_Handler,
@@ -1479,6 +2020,11 @@ var ChainService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ChainService_ChainHeadEvent_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ChainHeaderEvent",
+			Handler:       _ChainService_ChainHeaderEvent_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "trusted/v1/service.proto",
 }
@@ -0,0 +1,11 @@
+//go:build eclient
+
+package ratls
+
+import "github.com/edgelesssys/ego/eclient"
+
+// Clients outside an enclave verify reports with the host library, which needs
+// cgo and the Open Enclave host verification library.
+func init() {
+	VerifyRemoteReport = eclient.VerifyRemoteReport
+}
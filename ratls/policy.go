@@ -0,0 +1,46 @@
+package ratls
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/edgelesssys/ego/attestation"
+)
+
+// Policy describes the enclaves a client accepts. Either the unique id is
+// pinned, or the signer id with the product id and a minimum security version.
+type Policy struct {
+	UniqueID           []byte // MRENCLAVE of the accepted enclave build
+	SignerID           []byte // MRSIGNER of the accepted enclave signer
+	ProductID          uint16 // ISVPRODID, checked with SignerID
+	MinSecurityVersion uint   // lowest accepted ISVSVN
+	AllowDebug         bool   // accept debug enclaves, whose memory isn't protected
+	AllowOutdatedTCB   bool   // accept platforms whose TCB isn't up to date
+}
+
+// Verify checks the report of a verified remote report against the policy.
+func (p Policy) Verify(report attestation.Report) error {
+	if report.Debug && !p.AllowDebug {
+		return errors.New("debug enclave not accepted")
+	}
+	if report.SecurityVersion < p.MinSecurityVersion {
+		return fmt.Errorf("security version %d below %d", report.SecurityVersion, p.MinSecurityVersion)
+	}
+	switch {
+	case len(p.UniqueID) > 0:
+		if !bytes.Equal(report.UniqueID, p.UniqueID) {
+			return fmt.Errorf("unique id mismatch: have %x", report.UniqueID)
+		}
+	case len(p.SignerID) > 0:
+		if !bytes.Equal(report.SignerID, p.SignerID) {
+			return fmt.Errorf("signer id mismatch: have %x", report.SignerID)
+		}
+		if len(report.ProductID) < 2 || binary.LittleEndian.Uint16(report.ProductID) != p.ProductID {
+			return fmt.Errorf("product id mismatch: have %x", report.ProductID)
+		}
+	default:
+		return errors.New("policy pins neither unique id nor signer id")
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+// Package ratls implements attested TLS in the style of EGo. The server
+// certificate is generated inside the enclave and embeds a remote attestation
+// report over its public key, clients accept it if the report satisfies their
+// measurement policy.
+package ratls
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"github.com/edgelesssys/ego/attestation"
+	"github.com/edgelesssys/ego/enclave"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"math/big"
+	"time"
+)
+
+// certValidity is the lifetime of a generated server certificate.
+const certValidity = 365 * 24 * time.Hour
+
+// oidOeNewQuote is the certificate extension carrying the report, as used by
+// Open Enclave and EGo.
+var oidOeNewQuote = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 105, 1}
+
+// VerifyRemoteReport verifies the signature of a remote report. Inside an
+// enclave the runtime verifies it, host clients built with the eclient tag use
+// the Open Enclave host verification library.
+var VerifyRemoteReport = enclave.VerifyRemoteReport
+
+func hashPublicKey(pub interface{}) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(der)
+	return hash[:], nil
+}
+
+// ServerTLSConfig returns a TLS config with a fresh self-signed certificate
+// embedding a report of the enclave. It fails outside an enclave.
+func ServerTLSConfig() (*tls.Config, error) {
+	return newServerTLSConfig(enclave.GetRemoteReport)
+}
+
+func newServerTLSConfig(getRemoteReport func([]byte) ([]byte, error)) (*tls.Config, error) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := hashPublicKey(&priv.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	report, err := getRemoteReport(hash)
+	if err != nil {
+		return nil, fmt.Errorf("get remote report failed: %w", err)
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:    serial,
+		Subject:         pkix.Name{CommonName: "trusted-engine"},
+		NotBefore:       now.Add(-time.Minute),
+		NotAfter:        now.Add(certValidity),
+		ExtraExtensions: []pkix.Extension{{Id: oidOeNewQuote, Value: report}},
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &priv.PublicKey, priv)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{cert}, PrivateKey: priv}},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// ClientTLSConfig returns a TLS config accepting only servers whose certificate
+// embeds a valid report satisfying the policy. Client certificates can be added
+// to it for mutual authentication.
+func ClientTLSConfig(policy Policy) *tls.Config {
+	return newClientTLSConfig(VerifyRemoteReport, policy)
+}
+
+func newClientTLSConfig(verifyRemoteReport func([]byte) (attestation.Report, error), policy Policy) *tls.Config {
+	verify := func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return errors.New("no server certificate")
+		}
+		cert, err := x509.ParseCertificate(rawCerts[0])
+		if err != nil {
+			return err
+		}
+		// The certificate is self-signed, verify its signature and validity
+		roots := x509.NewCertPool()
+		roots.AddCert(cert)
+		if _, err := cert.Verify(x509.VerifyOptions{Roots: roots}); err != nil {
+			return err
+		}
+		hash, err := hashPublicKey(cert.PublicKey)
+		if err != nil {
+			return err
+		}
+		for _, ext := range cert.Extensions {
+			if !ext.Id.Equal(oidOeNewQuote) {
+				continue
+			}
+			report, err := verifyRemoteReport(ext.Value)
+			if err != nil && !(errors.Is(err, attestation.ErrTCBLevelInvalid) && policy.AllowOutdatedTCB) {
+				return fmt.Errorf("invalid attestation report: %w", err)
+			}
+			if len(report.Data) < len(hash) || !bytes.Equal(report.Data[:len(hash)], hash) {
+				return errors.New("attestation report does not match the certificate key")
+			}
+			return policy.Verify(report)
+		}
+		return errors.New("certificate has no attestation report")
+	}
+	// The chain is verified against the report instead of a CA
+	return &tls.Config{
+		InsecureSkipVerify:    true,
+		VerifyPeerCertificate: verify,
+		MinVersion:            tls.VersionTLS12,
+	}
+}
+
+// Dial connects to an attested TLS server satisfying the policy.
+func Dial(addr string, policy Policy, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	creds := credentials.NewTLS(ClientTLSConfig(policy))
+	return grpc.Dial(addr, append([]grpc.DialOption{grpc.WithTransportCredentials(creds)}, opts...)...)
+}
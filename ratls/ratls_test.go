@@ -0,0 +1,111 @@
+package ratls
+
+import (
+	"context"
+	"errors"
+	"github.com/edgelesssys/ego/attestation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	testUniqueID = []byte("unique-id")
+	testSignerID = []byte("signer-id")
+)
+
+// fakeReport stands in for the enclave runtime, the report is its data behind
+// a marker so the fake verifier can give it back.
+func fakeReport(data []byte) ([]byte, error) {
+	return append([]byte("report:"), data...), nil
+}
+
+func fakeVerify(debug bool) func([]byte) (attestation.Report, error) {
+	return func(report []byte) (attestation.Report, error) {
+		if !strings.HasPrefix(string(report), "report:") {
+			return attestation.Report{}, errors.New("bad signature")
+		}
+		return attestation.Report{
+			Data:            report[len("report:"):],
+			Debug:           debug,
+			SecurityVersion: 2,
+			UniqueID:        testUniqueID,
+			SignerID:        testSignerID,
+			ProductID:       []byte{7, 0},
+		}, nil
+	}
+}
+
+func startTestServer(t *testing.T) string {
+	tlsConfig, err := newServerTLSConfig(fakeReport)
+	if err != nil {
+		t.Fatalf("server tls config failed: %v", err)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen failed: %v", err)
+	}
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	return lis.Addr().String()
+}
+
+func check(addr string, verify func([]byte) (attestation.Report, error), policy Policy) error {
+	creds := credentials.NewTLS(newClientTLSConfig(verify, policy))
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, new(healthpb.HealthCheckRequest), grpc.WaitForReady(false))
+	return err
+}
+
+func TestAttestedTLS(t *testing.T) {
+	addr := startTestServer(t)
+
+	tests := []struct {
+		name   string
+		verify func([]byte) (attestation.Report, error)
+		policy Policy
+		ok     bool
+	}{
+		{"unique id", fakeVerify(false), Policy{UniqueID: testUniqueID}, true},
+		{"signer id", fakeVerify(false), Policy{SignerID: testSignerID, ProductID: 7, MinSecurityVersion: 2}, true},
+		{"other unique id", fakeVerify(false), Policy{UniqueID: []byte("other")}, false},
+		{"other product", fakeVerify(false), Policy{SignerID: testSignerID, ProductID: 8}, false},
+		{"old security version", fakeVerify(false), Policy{SignerID: testSignerID, ProductID: 7, MinSecurityVersion: 3}, false},
+		{"debug enclave", fakeVerify(true), Policy{UniqueID: testUniqueID}, false},
+		{"debug allowed", fakeVerify(true), Policy{UniqueID: testUniqueID, AllowDebug: true}, true},
+		{"empty policy", fakeVerify(false), Policy{}, false},
+	}
+	for _, tt := range tests {
+		if err := check(addr, tt.verify, tt.policy); (err == nil) != tt.ok {
+			t.Errorf("%s: have err %v, want ok %v", tt.name, err, tt.ok)
+		}
+	}
+}
+
+func TestAttestedTLSOtherKey(t *testing.T) {
+	// A report over another key must not vouch for the certificate
+	tlsConfig, err := newServerTLSConfig(func([]byte) ([]byte, error) {
+		return fakeReport(make([]byte, 32))
+	})
+	if err != nil {
+		t.Fatalf("server tls config failed: %v", err)
+	}
+	client := newClientTLSConfig(fakeVerify(false), Policy{UniqueID: testUniqueID})
+	if err := client.VerifyPeerCertificate(tlsConfig.Certificates[0].Certificate, nil); err == nil {
+		t.Fatal("report of another key accepted")
+	}
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/trusted-defi/trusted-engine/core/mempool"
+	"github.com/trusted-defi/trusted-engine/node"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// AdminService serves the runtime administration of the node.
+type AdminService struct {
+	n *node.Node
+	trusted.UnimplementedAdminServiceServer
+}
+
+func RegisterAdminService(server *grpc.Server, n *node.Node) {
+	trusted.RegisterAdminServiceServer(server, &AdminService{n: n})
+}
+
+func (s *AdminService) PoolConfig(ctx context.Context, req *emptypb.Empty) (*trusted.PoolConfigResponse, error) {
+	return &trusted.PoolConfigResponse{Config: toPoolConfig(s.n.TxPool().Config())}, nil
+}
+
+func (s *AdminService) SetPoolConfig(ctx context.Context, req *trusted.SetPoolConfigRequest) (*trusted.PoolConfigResponse, error) {
+	if req.Config == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing config")
+	}
+	conf := s.n.TxPool().Config()
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = poolConfigFields
+	}
+	for _, path := range paths {
+		if err := setPoolConfigField(&conf, req.Config, path); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	return &trusted.PoolConfigResponse{Config: toPoolConfig(s.n.SetTxPoolConfig(conf))}, nil
+}
+
+// poolConfigFields are the proto field names of the pool config.
+var poolConfigFields = []string{
+	"no_locals", "journal", "rejournal", "price_limit", "price_bump", "account_slots",
+	"global_slots", "account_queue", "global_queue", "lifetime", "max_reorg_depth",
+	"history_slots", "history_lifetime", "snapshot",
+}
+
+func setPoolConfigField(conf *mempool.TxPoolConfig, pc *trusted.PoolConfig, path string) error {
+	switch path {
+	case "no_locals":
+		conf.NoLocals = pc.NoLocals
+	case "journal":
+		conf.Journal = pc.Journal
+	case "rejournal":
+		conf.Rejournal = pc.Rejournal.AsDuration()
+	case "price_limit":
+		conf.PriceLimit = pc.PriceLimit
+	case "price_bump":
+		conf.PriceBump = pc.PriceBump
+	case "account_slots":
+		conf.AccountSlots = pc.AccountSlots
+	case "global_slots":
+		conf.GlobalSlots = pc.GlobalSlots
+	case "account_queue":
+		conf.AccountQueue = pc.AccountQueue
+	case "global_queue":
+		conf.GlobalQueue = pc.GlobalQueue
+	case "lifetime":
+		conf.Lifetime = pc.Lifetime.AsDuration()
+	case "max_reorg_depth":
+		conf.MaxReorgDepth = pc.MaxReorgDepth
+	case "history_slots":
+		conf.HistorySlots = pc.HistorySlots
+	case "history_lifetime":
+		conf.HistoryLifetime = pc.HistoryLifetime.AsDuration()
+	case "snapshot":
+		conf.Snapshot = pc.Snapshot
+	default:
+		return fmt.Errorf("unknown pool config field %q", path)
+	}
+	return nil
+}
+
+func toPoolConfig(conf mempool.TxPoolConfig) *trusted.PoolConfig {
+	return &trusted.PoolConfig{
+		NoLocals:      conf.NoLocals,
+		Journal:       conf.Journal,
+		Rejournal:     durationpb.New(conf.Rejournal),
+		PriceLimit:    conf.PriceLimit,
+		PriceBump:     conf.PriceBump,
+		AccountSlots:  conf.AccountSlots,
+		GlobalSlots:   conf.GlobalSlots,
+		AccountQueue:  conf.AccountQueue,
+		GlobalQueue:   conf.GlobalQueue,
+		Lifetime:      durationpb.New(conf.Lifetime),
+		MaxReorgDepth: conf.MaxReorgDepth,
+
+		HistorySlots:    conf.HistorySlots,
+		HistoryLifetime: durationpb.New(conf.HistoryLifetime),
+		Snapshot:        conf.Snapshot,
+	}
+}
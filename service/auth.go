@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/trusted-defi/trusted-engine/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strings"
+)
+
+// Role is a set of service methods granted to a caller.
+type Role string
+
+const (
+	RolePublic  Role = "public"  // transaction submission and status
+	RoleBuilder Role = "builder" // block filling and verification
+	RolePeer    Role = "peer"    // key handshake and encrypted transactions
+	RoleAdmin   Role = "admin"   // pool management and content, grants every role
+)
+
+const (
+	trustedMethodPrefix = "/trusted.v1.TrustedService/"
+	healthMethodPrefix  = "/grpc.health.v1.Health/"
+)
+
+// methodRoles maps the TrustedService methods to the role needed to call them.
+// Methods not listed need the admin role.
+var methodRoles = map[string]Role{
+	"ServiceReady":        RolePublic,
+	"PoolGasPrice":        RolePublic,
+	"PendingNonce":        RolePublic, // owner-signed, see ownerAuth
+	"PoolStat":            RolePublic,
+	"AddRemoteTx":         RolePublic,
+	"Crypt":               RolePublic, // encrypts to the node key
+	"AddRemoteTrustedTxs": RolePublic, // encrypted submission
+	"TxStatus":            RolePublic,
+	"TxHas":               RolePublic,
+	"WatchTransactions":   RolePublic,
+	"PoolContentFrom":     RolePublic, // owner-signed, see ownerAuth
+	"TxGet":               RolePublic, // owner-signed, see ownerAuth
+
+	"FillBlock":            RoleBuilder,
+	"CommittedBlockVerify": RoleBuilder,
+
+	"SubscribeNewTransaction": RolePeer,
+	"CheckSecretKey":          RolePeer,
+	"GetAuthData":             RolePeer,
+	"VerifyAuth":              RolePeer,
+	"GetVerifyData":           RolePeer,
+	"VerifyRemoteVerify":      RolePeer,
+	"GetRequestKeyData":       RolePeer,
+	"VerifyRequestKeyData":    RolePeer,
+	"GetResponseKeyData":      RolePeer,
+	"VerifyResponseKey":       RolePeer,
+}
+
+// methodRole returns the role needed to call the method.
+func methodRole(method string) Role {
+	if strings.HasPrefix(method, trustedMethodPrefix) {
+		if role, ok := methodRoles[strings.TrimPrefix(method, trustedMethodPrefix)]; ok {
+			return role
+		}
+	}
+	return RoleAdmin
+}
+
+// caller is an authenticated client and its roles.
+type caller struct {
+	name  string
+	roles map[Role]bool
+}
+
+func (c *caller) has(role Role) bool {
+	return c.roles[role] || c.roles[RoleAdmin]
+}
+
+// authenticator identifies callers by API token or client certificate and
+// checks their roles against the called method.
+type authenticator struct {
+	anonymous    *caller
+	tokens       map[[32]byte]*caller // by token hash, so lookups don't leak the token timing
+	subjects     map[string]*caller
+	fingerprints map[string]*caller
+	audit        bool // log allowed calls at info
+}
+
+func newAuthenticator(conf config.AuthConfig) (*authenticator, error) {
+	a := &authenticator{
+		tokens:       make(map[[32]byte]*caller),
+		subjects:     make(map[string]*caller),
+		fingerprints: make(map[string]*caller),
+		audit:        conf.Audit,
+	}
+	var err error
+	if a.anonymous, err = newCaller("anonymous", conf.Anonymous); err != nil {
+		return nil, err
+	}
+	for _, t := range conf.Tokens {
+		if t.Token == "" {
+			return nil, fmt.Errorf("empty auth token %q", t.Name)
+		}
+		c, err := newCaller(t.Name, t.Roles)
+		if err != nil {
+			return nil, err
+		}
+		a.tokens[sha256.Sum256([]byte(t.Token))] = c
+	}
+	for _, cert := range conf.Certs {
+		name := cert.Name
+		if name == "" {
+			name = cert.Subject
+		}
+		c, err := newCaller(name, cert.Roles)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case cert.Fingerprint != "":
+			a.fingerprints[strings.ToLower(cert.Fingerprint)] = c
+		case cert.Subject != "":
+			a.subjects[cert.Subject] = c
+		default:
+			return nil, fmt.Errorf("auth cert %q has neither subject nor fingerprint", cert.Name)
+		}
+	}
+	return a, nil
+}
+
+func newCaller(name string, roles []string) (*caller, error) {
+	c := &caller{name: name, roles: make(map[Role]bool)}
+	for _, role := range roles {
+		switch r := Role(role); r {
+		case RolePublic, RoleBuilder, RolePeer, RoleAdmin:
+			c.roles[r] = true
+		default:
+			return nil, fmt.Errorf("unknown role %q of %q", role, name)
+		}
+	}
+	return c, nil
+}
+
+// identify returns the caller of the request. A presented token has to be
+// known, a client certificate only counts if it was verified by the server.
+func (a *authenticator) identify(ctx context.Context) (*caller, error) {
+	var (
+		token    string
+		hasToken bool
+		chains   [][]*x509.Certificate
+	)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			token, hasToken = values[0], true
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			chains = info.State.VerifiedChains
+		}
+	}
+	return a.lookup(token, hasToken, chains)
+}
+
+// identifyRequest returns the caller of an http request, like identify.
+func (a *authenticator) identifyRequest(r *http.Request) (*caller, error) {
+	var chains [][]*x509.Certificate
+	if r.TLS != nil {
+		chains = r.TLS.VerifiedChains
+	}
+	values := r.Header.Values("Authorization")
+	if len(values) > 0 {
+		return a.lookup(values[0], true, chains)
+	}
+	return a.lookup("", false, chains)
+}
+
+// lookup returns the caller of the authorization token if one was presented,
+// else of the verified client certificate.
+func (a *authenticator) lookup(authorization string, hasToken bool, chains [][]*x509.Certificate) (*caller, error) {
+	if hasToken {
+		token := strings.TrimPrefix(authorization, "Bearer ")
+		if c, ok := a.tokens[sha256.Sum256([]byte(token))]; ok {
+			return c, nil
+		}
+		return nil, status.Error(codes.Unauthenticated, "unknown token")
+	}
+	if len(chains) > 0 {
+		leaf := chains[0][0]
+		fingerprint := sha256.Sum256(leaf.Raw)
+		if c, ok := a.fingerprints[hex.EncodeToString(fingerprint[:])]; ok {
+			return c, nil
+		}
+		if c, ok := a.subjects[leaf.Subject.CommonName]; ok {
+			return c, nil
+		}
+	}
+	return a.anonymous, nil
+}
+
+// authorize checks that the caller of the request may call the method, and
+// logs the decision.
+func (a *authenticator) authorize(ctx context.Context, method string) error {
+	if strings.HasPrefix(method, healthMethodPrefix) {
+		return nil
+	}
+	role := methodRole(method)
+	logger := log.WithFields(logrus.Fields{"method": method, "role": role})
+	if p, ok := peer.FromContext(ctx); ok {
+		logger = logger.WithField("addr", p.Addr.String())
+	}
+	c, err := a.identify(ctx)
+	if err != nil {
+		logger.WithField("err", err).Warn("request denied")
+		return err
+	}
+	logger = logger.WithField("caller", c.name)
+	if !c.has(role) {
+		logger.Warn("request denied")
+		return status.Errorf(codes.PermissionDenied, "%s role required", role)
+	}
+	if a.audit {
+		logger.Info("request allowed")
+	} else {
+		logger.Debug("request allowed")
+	}
+	return nil
+}
+
+func (a *authenticator) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *authenticator) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
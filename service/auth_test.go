@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/trusted-defi/trusted-engine/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"net"
+	"testing"
+)
+
+func tokenContext(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func certContext(cert *x509.Certificate) context.Context {
+	info := credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}}
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{}, AuthInfo: info})
+}
+
+func TestAuthorize(t *testing.T) {
+	builderCert := &x509.Certificate{Raw: []byte("builder"), Subject: pkix.Name{CommonName: "builder.local"}}
+	peerCert := &x509.Certificate{Raw: []byte("peer"), Subject: pkix.Name{CommonName: "unknown"}}
+	fingerprint := sha256.Sum256(peerCert.Raw)
+
+	auth, err := newAuthenticator(config.AuthConfig{
+		Enabled:   true,
+		Anonymous: []string{"public"},
+		Tokens:    []config.AuthToken{{Name: "ops", Token: "secret", Roles: []string{"admin"}}},
+		Certs: []config.AuthCert{
+			{Subject: "builder.local", Roles: []string{"builder"}},
+			{Name: "peer", Fingerprint: hex.EncodeToString(fingerprint[:]), Roles: []string{"peer"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("new authenticator failed: %v", err)
+	}
+	tests := []struct {
+		ctx    context.Context
+		method string
+		code   codes.Code
+	}{
+		{context.Background(), "/trusted.v1.TrustedService/AddRemoteTx", codes.OK},
+		{context.Background(), "/trusted.v1.TrustedService/PoolSetPrice", codes.PermissionDenied},
+		{context.Background(), "/trusted.v1.TrustedService/FillBlock", codes.PermissionDenied},
+		{context.Background(), "/trusted.v1.AdminService/SetPoolConfig", codes.PermissionDenied},
+		{context.Background(), "/grpc.health.v1.Health/Check", codes.OK},
+		{tokenContext("secret"), "/trusted.v1.TrustedService/PoolSetPrice", codes.OK},
+		{tokenContext("secret"), "/trusted.v1.TrustedService/FillBlock", codes.OK},
+		{tokenContext("wrong"), "/trusted.v1.TrustedService/AddRemoteTx", codes.Unauthenticated},
+		{certContext(builderCert), "/trusted.v1.TrustedService/FillBlock", codes.OK},
+		{certContext(builderCert), "/trusted.v1.TrustedService/VerifyAuth", codes.PermissionDenied},
+		{certContext(peerCert), "/trusted.v1.TrustedService/VerifyAuth", codes.OK},
+		{certContext(peerCert), "/trusted.v1.TrustedService/PoolContent", codes.PermissionDenied},
+		{context.Background(), "/trusted.v1.TrustedService/AddLocalTrustedTxs", codes.PermissionDenied},
+		{certContext(peerCert), "/trusted.v1.TrustedService/AddLocalTrustedTxs", codes.PermissionDenied},
+		{tokenContext("secret"), "/trusted.v1.TrustedService/AddLocalTrustedTxs", codes.OK},
+		{context.Background(), "/trusted.v1.TrustedService/AddRemoteTrustedTxs", codes.OK},
+		{context.Background(), "/trusted.v1.TrustedService/Crypt", codes.OK},
+		{context.Background(), "/trusted.v1.TrustedService/AddLocalsTx", codes.PermissionDenied},
+		{context.Background(), "/trusted.v1.TrustedService/SubscribeNewTransaction", codes.PermissionDenied},
+	}
+	for i, tt := range tests {
+		if code := status.Code(auth.authorize(tt.ctx, tt.method)); code != tt.code {
+			t.Errorf("test %d %s: code mismatch: have %v, want %v", i, tt.method, code, tt.code)
+		}
+	}
+}
+
+// Tests that allowed calls are only logged at info with audit enabled.
+func TestAuthorizeAudit(t *testing.T) {
+	defer log.Logger.ReplaceHooks(log.Logger.ReplaceHooks(make(logrus.LevelHooks)))
+	hook := test.NewLocal(log.Logger)
+	defer func(level logrus.Level) { log.Logger.SetLevel(level) }(log.Logger.GetLevel())
+	log.Logger.SetLevel(logrus.InfoLevel)
+
+	for _, audit := range []bool{false, true} {
+		hook.Reset()
+		auth, err := newAuthenticator(config.AuthConfig{Enabled: true, Audit: audit, Anonymous: []string{"public"}})
+		if err != nil {
+			t.Fatalf("new authenticator failed: %v", err)
+		}
+		if err := auth.authorize(context.Background(), "/trusted.v1.TrustedService/AddRemoteTx"); err != nil {
+			t.Fatalf("audit %v: public call denied: %v", audit, err)
+		}
+		entry := hook.LastEntry()
+		if logged := entry != nil && entry.Message == "request allowed" && entry.Level == logrus.InfoLevel; logged != audit {
+			t.Errorf("audit %v: allowed call logged %v", audit, logged)
+		}
+		if entry != nil && (entry.Data["caller"] != "anonymous" || entry.Data["method"] == nil) {
+			t.Errorf("audit entry lacks caller or method: %v", entry.Data)
+		}
+	}
+}
+
+func TestAuthConfigInvalid(t *testing.T) {
+	if _, err := newAuthenticator(config.AuthConfig{Anonymous: []string{"root"}}); err == nil {
+		t.Error("unknown role accepted")
+	}
+	if _, err := newAuthenticator(config.AuthConfig{Tokens: []config.AuthToken{{Name: "empty"}}}); err == nil {
+		t.Error("empty token accepted")
+	}
+	if _, err := newAuthenticator(config.AuthConfig{Certs: []config.AuthCert{{Name: "any", Roles: []string{"admin"}}}}); err == nil {
+		t.Error("certificate without subject and fingerprint accepted")
+	}
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"encoding/binary"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/trusted-defi/trusted-engine/core/mempool"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPageSize = 256
+	maxPageSize     = 4096
+
+	// maxPageBytes limits the encoded transactions of a page, so pages of
+	// large transactions stay below the grpc message limit.
+	maxPageBytes = 2 * 1024 * 1024
+
+	pageTokenLength = common.AddressLength + 8
+)
+
+// encodePageToken returns the token continuing after the transaction.
+func encodePageToken(cursor *mempool.ContentCursor) []byte {
+	token := make([]byte, pageTokenLength)
+	copy(token, cursor.Sender[:])
+	binary.BigEndian.PutUint64(token[common.AddressLength:], cursor.Nonce)
+	return token
+}
+
+func decodePageToken(token []byte) (*mempool.ContentCursor, error) {
+	if len(token) == 0 {
+		return nil, nil
+	}
+	if len(token) != pageTokenLength {
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+	return &mempool.ContentCursor{
+		Sender: common.BytesToAddress(token[:common.AddressLength]),
+		Nonce:  binary.BigEndian.Uint64(token[common.AddressLength:]),
+	}, nil
+}
+
+// contentQuery returns the pool query of the request and its page size.
+func contentQuery(req *trusted.PoolQueryRequest) (mempool.ContentQuery, int, error) {
+	var q mempool.ContentQuery
+	switch req.State {
+	case trusted.TxState_TX_STATE_ANY:
+		q.Pending, q.Queued = true, true
+	case trusted.TxState_TX_STATE_PENDING:
+		q.Pending = true
+	case trusted.TxState_TX_STATE_QUEUED:
+		q.Queued = true
+	default:
+		return q, 0, status.Errorf(codes.InvalidArgument, "unknown state %v", req.State)
+	}
+	switch req.Origin {
+	case trusted.TxOrigin_TX_ORIGIN_ANY:
+		q.Locals, q.Remotes = true, true
+	case trusted.TxOrigin_TX_ORIGIN_LOCAL:
+		q.Locals = true
+	case trusted.TxOrigin_TX_ORIGIN_REMOTE:
+		q.Remotes = true
+	default:
+		return q, 0, status.Errorf(codes.InvalidArgument, "unknown origin %v", req.Origin)
+	}
+	if len(req.MinTip) > 0 {
+		q.MinTip = toBigInt(req.MinTip)
+	}
+	var err error
+	if q.After, err = decodePageToken(req.PageToken); err != nil {
+		return q, 0, err
+	}
+	pageSize := int(req.PageSize)
+	switch {
+	case pageSize == 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+	return q, pageSize, nil
+}
+
+// nextPage encodes the transactions of the next page, up to the page size and
+// byte limit, and returns the ones left.
+func nextPage(txs []*mempool.ContentTx, pageSize int) (*trusted.PoolQueryResponse, []*mempool.ContentTx) {
+	res := new(trusted.PoolQueryResponse)
+	size := 0
+	for len(txs) > 0 && len(res.Txs) < pageSize {
+		data, err := txs[0].Tx.MarshalBinary()
+		if err != nil {
+			log.WithField("err", err).Error("encode transaction failed")
+			txs = txs[1:]
+			continue
+		}
+		if size += len(data); size > maxPageBytes && len(res.Txs) > 0 {
+			break
+		}
+		res.Txs = append(res.Txs, &trusted.PoolTransaction{
+			Sender:  txs[0].Sender.Bytes(),
+			Tx:      data,
+			Pending: txs[0].Pending,
+			Local:   txs[0].Local,
+		})
+		res.NextPageToken = encodePageToken(txs[0].Cursor())
+		txs = txs[1:]
+	}
+	return res, txs
+}
+
+func (s *TrustedService) PoolQuery(ctx context.Context, req *trusted.PoolQueryRequest) (*trusted.PoolQueryResponse, error) {
+	q, pageSize, err := contentQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	q.Limit = pageSize
+	txs, more := s.n.TxPool().QueryContent(q)
+	res, rest := nextPage(txs, pageSize)
+	if !more && len(rest) == 0 {
+		res.NextPageToken = nil
+	}
+	return res, nil
+}
+
+func (s *TrustedService) PoolQueryStream(req *trusted.PoolQueryRequest, server trusted.TrustedService_PoolQueryStreamServer) error {
+	q, pageSize, err := contentQuery(req)
+	if err != nil {
+		return err
+	}
+	// The snapshot is taken once, the pool isn't locked while sending it
+	txs, _ := s.n.TxPool().QueryContent(q)
+	for {
+		var res *trusted.PoolQueryResponse
+		res, txs = nextPage(txs, pageSize)
+		if len(txs) == 0 {
+			res.NextPageToken = nil
+		}
+		if err := server.Send(res); err != nil {
+			return err
+		}
+		if len(txs) == 0 {
+			return nil
+		}
+		select {
+		case <-server.Context().Done():
+			return nil
+		case <-s.quit:
+			return status.Error(codes.Unavailable, "service stopped")
+		default:
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"bytes"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/trusted-defi/trusted-engine/core/mempool"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestContentQuery(t *testing.T) {
+	cursor := &mempool.ContentCursor{Sender: common.HexToAddress("0xaa"), Nonce: 7}
+	q, pageSize, err := contentQuery(&trusted.PoolQueryRequest{
+		State:     trusted.TxState_TX_STATE_QUEUED,
+		Origin:    trusted.TxOrigin_TX_ORIGIN_REMOTE,
+		MinTip:    []byte{0x10},
+		PageSize:  maxPageSize + 1,
+		PageToken: encodePageToken(cursor),
+	})
+	if err != nil {
+		t.Fatalf("query failed: %v", err)
+	}
+	if q.Pending || !q.Queued || q.Locals || !q.Remotes || q.MinTip.Int64() != 16 || *q.After != *cursor || pageSize != maxPageSize {
+		t.Errorf("query mismatch: %+v, page size %d", q, pageSize)
+	}
+	if q, pageSize, _ := contentQuery(&trusted.PoolQueryRequest{}); !q.Pending || !q.Queued || !q.Locals || !q.Remotes || q.MinTip != nil || q.After != nil || pageSize != defaultPageSize {
+		t.Errorf("default query mismatch: %+v, page size %d", q, pageSize)
+	}
+	for _, req := range []*trusted.PoolQueryRequest{{PageToken: []byte{0x01}}, {State: 5}, {Origin: 5}} {
+		if _, _, err := contentQuery(req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("invalid request %v accepted: %v", req, err)
+		}
+	}
+}
+
+func TestNextPage(t *testing.T) {
+	sender := common.HexToAddress("0xaa")
+	var txs []*mempool.ContentTx
+	for i := uint64(0); i < 5; i++ {
+		tx := types.NewTx(&types.LegacyTx{Nonce: i, Data: make([]byte, maxPageBytes/2)})
+		txs = append(txs, &mempool.ContentTx{Sender: sender, Tx: tx, Pending: true})
+	}
+	// Two transactions of half the byte limit overflow it
+	res, rest := nextPage(txs, 4)
+	if len(res.Txs) != 1 || len(rest) != 4 {
+		t.Fatalf("page size mismatch: have %d, rest %d", len(res.Txs), len(rest))
+	}
+	if want := encodePageToken(txs[0].Cursor()); !bytes.Equal(res.NextPageToken, want) {
+		t.Errorf("page token mismatch: have %x, want %x", res.NextPageToken, want)
+	}
+	if after, err := decodePageToken(res.NextPageToken); err != nil || after.Sender != sender || after.Nonce != 0 {
+		t.Errorf("page token not decoded: %+v, err %v", after, err)
+	}
+	small := []*mempool.ContentTx{{Sender: sender, Tx: types.NewTx(&types.LegacyTx{})}, {Sender: sender, Tx: types.NewTx(&types.LegacyTx{Nonce: 1})}}
+	if res, rest := nextPage(small, 1); len(res.Txs) != 1 || len(rest) != 1 {
+		t.Errorf("page size not applied: have %d, rest %d", len(res.Txs), len(rest))
+	}
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/trusted-defi/trusted-engine/core/mempool"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"github.com/trusted-defi/trusted-engine/smanager"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+)
+
+const errorDomain = "trusted.v1"
+
+var (
+	errTxMalformed = errors.New("malformed transaction")
+	errTxDecrypt   = errors.New("decrypt transaction failed")
+)
+
+// txErrorCodes maps the errors of decoding and adding transactions to their
+// codes, errors not listed are TX_ERROR_INTERNAL.
+var txErrorCodes = []struct {
+	err  error
+	code trusted.TxErrorCode
+}{
+	{errTxMalformed, trusted.TxErrorCode_TX_ERROR_MALFORMED},
+	{errTxDecrypt, trusted.TxErrorCode_TX_ERROR_DECRYPT},
+	{mempool.ErrAlreadyKnown, trusted.TxErrorCode_TX_ERROR_ALREADY_KNOWN},
+	{mempool.ErrInvalidSender, trusted.TxErrorCode_TX_ERROR_INVALID_SENDER},
+	{mempool.ErrUnderpriced, trusted.TxErrorCode_TX_ERROR_UNDERPRICED},
+	{mempool.ErrReplaceUnderpriced, trusted.TxErrorCode_TX_ERROR_REPLACE_UNDERPRICED},
+	{mempool.ErrTxPoolOverflow, trusted.TxErrorCode_TX_ERROR_POOL_OVERFLOW},
+	{mempool.ErrGasLimit, trusted.TxErrorCode_TX_ERROR_GAS_LIMIT},
+	{mempool.ErrNegativeValue, trusted.TxErrorCode_TX_ERROR_NEGATIVE_VALUE},
+	{mempool.ErrOversizedData, trusted.TxErrorCode_TX_ERROR_OVERSIZED_DATA},
+	{core.ErrNonceTooLow, trusted.TxErrorCode_TX_ERROR_NONCE_TOO_LOW},
+	{core.ErrInsufficientFunds, trusted.TxErrorCode_TX_ERROR_INSUFFICIENT_FUNDS},
+	{core.ErrIntrinsicGas, trusted.TxErrorCode_TX_ERROR_INTRINSIC_GAS},
+	{core.ErrTxTypeNotSupported, trusted.TxErrorCode_TX_ERROR_TYPE_NOT_SUPPORTED},
+	{core.ErrFeeCapVeryHigh, trusted.TxErrorCode_TX_ERROR_FEE_CAP_VERY_HIGH},
+	{core.ErrTipVeryHigh, trusted.TxErrorCode_TX_ERROR_TIP_VERY_HIGH},
+	{core.ErrTipAboveFeeCap, trusted.TxErrorCode_TX_ERROR_TIP_ABOVE_FEE_CAP},
+}
+
+// txErrorStatusCodes maps the transaction error codes to grpc status codes,
+// codes not listed are codes.Internal.
+var txErrorStatusCodes = map[trusted.TxErrorCode]codes.Code{
+	trusted.TxErrorCode_TX_ERROR_NONE:                codes.OK,
+	trusted.TxErrorCode_TX_ERROR_MALFORMED:           codes.InvalidArgument,
+	trusted.TxErrorCode_TX_ERROR_DECRYPT:             codes.InvalidArgument,
+	trusted.TxErrorCode_TX_ERROR_ALREADY_KNOWN:       codes.AlreadyExists,
+	trusted.TxErrorCode_TX_ERROR_INVALID_SENDER:      codes.InvalidArgument,
+	trusted.TxErrorCode_TX_ERROR_UNDERPRICED:         codes.FailedPrecondition,
+	trusted.TxErrorCode_TX_ERROR_REPLACE_UNDERPRICED: codes.FailedPrecondition,
+	trusted.TxErrorCode_TX_ERROR_POOL_OVERFLOW:       codes.ResourceExhausted,
+	trusted.TxErrorCode_TX_ERROR_GAS_LIMIT:           codes.InvalidArgument,
+	trusted.TxErrorCode_TX_ERROR_NEGATIVE_VALUE:      codes.InvalidArgument,
+	trusted.TxErrorCode_TX_ERROR_OVERSIZED_DATA:      codes.InvalidArgument,
+	trusted.TxErrorCode_TX_ERROR_NONCE_TOO_LOW:       codes.FailedPrecondition,
+	trusted.TxErrorCode_TX_ERROR_INSUFFICIENT_FUNDS:  codes.FailedPrecondition,
+	trusted.TxErrorCode_TX_ERROR_INTRINSIC_GAS:       codes.InvalidArgument,
+	trusted.TxErrorCode_TX_ERROR_TYPE_NOT_SUPPORTED:  codes.InvalidArgument,
+	trusted.TxErrorCode_TX_ERROR_FEE_CAP_VERY_HIGH:   codes.InvalidArgument,
+	trusted.TxErrorCode_TX_ERROR_TIP_VERY_HIGH:       codes.InvalidArgument,
+	trusted.TxErrorCode_TX_ERROR_TIP_ABOVE_FEE_CAP:   codes.InvalidArgument,
+}
+
+// newTxError returns the result of adding a transaction, nil err means it was
+// added.
+func newTxError(err error) *trusted.TxError {
+	if err == nil {
+		return &trusted.TxError{Code: trusted.TxErrorCode_TX_ERROR_NONE}
+	}
+	txErr := &trusted.TxError{Code: trusted.TxErrorCode_TX_ERROR_INTERNAL, Message: err.Error()}
+	for _, c := range txErrorCodes {
+		if errors.Is(err, c.err) {
+			txErr.Code = c.code
+			break
+		}
+	}
+	return txErr
+}
+
+// txStatusCode returns the grpc status code of a transaction error code.
+func txStatusCode(code trusted.TxErrorCode) codes.Code {
+	if c, ok := txErrorStatusCodes[code]; ok {
+		return c
+	}
+	return codes.Internal
+}
+
+// txsStatus returns nil if a transaction of the batch was added, else the
+// status of the first error with an ErrorInfo detail for each transaction.
+func txsStatus(txErrs []*trusted.TxError) error {
+	if len(txErrs) == 0 {
+		return nil
+	}
+	details := make([]*errdetails.ErrorInfo, len(txErrs))
+	for i, txErr := range txErrs {
+		if txErr.Code == trusted.TxErrorCode_TX_ERROR_NONE {
+			return nil
+		}
+		details[i] = &errdetails.ErrorInfo{
+			Reason:   txErr.Code.String(),
+			Domain:   errorDomain,
+			Metadata: map[string]string{"index": strconv.Itoa(i), "message": txErr.Message},
+		}
+	}
+	st := status.New(txStatusCode(txErrs[0].Code), fmt.Sprintf("no transaction added: %s", txErrs[0].Message))
+	for _, detail := range details {
+		if withDetail, err := st.WithDetails(detail); err == nil {
+			st = withDetail
+		}
+	}
+	return st.Err()
+}
+
+// keyStatus returns the status of a failed key handshake step. Steps out of
+// order are failed preconditions, other failures are invalid handshake data.
+func keyStatus(err error) error {
+	if errors.Is(err, smanager.ErrInvalidOperation) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.InvalidArgument, err.Error())
+}
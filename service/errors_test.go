@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/trusted-defi/trusted-engine/core/mempool"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestTxErrors(t *testing.T) {
+	tests := []struct {
+		err  error
+		code trusted.TxErrorCode
+	}{
+		{nil, trusted.TxErrorCode_TX_ERROR_NONE},
+		{mempool.ErrUnderpriced, trusted.TxErrorCode_TX_ERROR_UNDERPRICED},
+		{mempool.ErrTxPoolOverflow, trusted.TxErrorCode_TX_ERROR_POOL_OVERFLOW},
+		{core.ErrNonceTooLow, trusted.TxErrorCode_TX_ERROR_NONCE_TOO_LOW},
+		{fmt.Errorf("%w: short", errTxDecrypt), trusted.TxErrorCode_TX_ERROR_DECRYPT},
+		{fmt.Errorf("other"), trusted.TxErrorCode_TX_ERROR_INTERNAL},
+	}
+	for _, tt := range tests {
+		if txErr := newTxError(tt.err); txErr.Code != tt.code {
+			t.Errorf("%v: code mismatch: have %v, want %v", tt.err, txErr.Code, tt.code)
+		}
+	}
+
+	// Malformed transactions get an error, they are never added
+	txs, errs := parseListToTransactions(&trusted.TransactionList{Txs: [][]byte{{0x01}, {}}})
+	for i := range txs {
+		if txs[i] != nil || newTxError(errs[i]).Code != trusted.TxErrorCode_TX_ERROR_MALFORMED {
+			t.Errorf("malformed tx %d decoded: %v, err %v", i, txs[i], errs[i])
+		}
+	}
+	if txs, errs := parseListToTransactions(nil); len(txs) != 0 || len(errs) != 0 {
+		t.Errorf("missing list decoded: %v, %v", txs, errs)
+	}
+}
+
+func TestTxsStatus(t *testing.T) {
+	added := newTxError(nil)
+	overflow := newTxError(mempool.ErrTxPoolOverflow)
+	malformed := newTxError(errTxMalformed)
+
+	if err := txsStatus(nil); err != nil {
+		t.Errorf("empty batch failed: %v", err)
+	}
+	if err := txsStatus([]*trusted.TxError{overflow, added}); err != nil {
+		t.Errorf("partly added batch failed: %v", err)
+	}
+	st := status.Convert(txsStatus([]*trusted.TxError{overflow, malformed}))
+	if st.Code() != codes.ResourceExhausted {
+		t.Errorf("code mismatch: have %v, want %v", st.Code(), codes.ResourceExhausted)
+	}
+	details := st.Details()
+	if len(details) != 2 {
+		t.Fatalf("details mismatch: have %d, want 2", len(details))
+	}
+	for i, want := range []*trusted.TxError{overflow, malformed} {
+		info, ok := details[i].(*errdetails.ErrorInfo)
+		if !ok || info.Reason != want.Code.String() || info.Metadata["index"] != fmt.Sprint(i) || info.Metadata["message"] != want.Message {
+			t.Errorf("detail %d mismatch: have %v, want %v", i, details[i], want)
+		}
+	}
+}
+
+func TestCommittedBlockVerifyMalformed(t *testing.T) {
+	s := new(TrustedService)
+	for _, data := range [][]byte{nil, {0x01}} {
+		res, err := s.CommittedBlockVerify(context.Background(), &trusted.CommittedBlockVerifyRequest{BlockData: data})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("block data %x: code mismatch: have %v (response %v), want %v", data, err, res, codes.InvalidArgument)
+		}
+	}
+}
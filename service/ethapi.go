@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/trusted-defi/trusted-engine/node"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"math/big"
+)
+
+// ethAPI serves the account state of the pool and takes transactions in the
+// eth namespace. The pending nonces of the pool are only served with pending
+// set, they are protected like PendingNonce.
+type ethAPI struct {
+	n       *node.Node
+	pending bool
+}
+
+// GetTransactionCount returns the nonce of the account, at the pending state
+// of the pool or at a block of the chain.
+func (api *ethAPI) GetTransactionCount(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Uint64, error) {
+	var nonce uint64
+	number, isNumber := blockNrOrHash.Number()
+	switch {
+	case isNumber && number == rpc.PendingBlockNumber:
+		if !api.pending {
+			return nil, errors.New("pending nonces need an owner-signed PendingNonce or the admin role")
+		}
+		nonce = api.n.TxPool().Nonce(address)
+	case isNumber && number == rpc.LatestBlockNumber:
+		nonce = api.n.Chain().NonceAt(address)
+	case isNumber && number >= 0:
+		nonce = api.n.Chain().NonceAtHeight(address, big.NewInt(number.Int64()))
+	default:
+		return nil, errors.New("only pending, latest and block numbers are supported")
+	}
+	return (*hexutil.Uint64)(&nonce), nil
+}
+
+// SendRawTransaction adds the signed transaction to the pool as remote, like
+// AddRemoteTx, and returns its hash.
+func (api *ethAPI) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, err
+	}
+	if err := api.n.TxPool().AddRemote(tx); err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), nil
+}
+
+// ethSubscribeAPI notifies new pool transactions, like SubscribeNewTransaction
+// but with the hashes only.
+type ethSubscribeAPI struct {
+	n    *node.Node
+	quit <-chan struct{}
+}
+
+// NewPendingTransactions notifies the hash of each transaction added to the
+// pool.
+func (api *ethSubscribeAPI) NewPendingTransactions(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		txs := make(chan core.NewTxsEvent, 128)
+		sub := api.n.TxPool().SubscribeNewTxsEvent(txs)
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case event := <-txs:
+				for _, tx := range event.Txs {
+					notifier.Notify(rpcSub.ID, tx.Hash())
+				}
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			case <-sub.Err():
+				return
+			case <-api.quit:
+				return
+			}
+		}
+	}()
+	return rpcSub, nil
+}
+
+// txpoolAPI serves the size of the pool in the txpool namespace.
+type txpoolAPI struct {
+	n *node.Node
+}
+
+// Status returns the number of pending and queued transactions, coarse in
+// privacy mode.
+func (api *txpoolAPI) Status() map[string]hexutil.Uint {
+	pending, queue := api.n.TxPool().Stats()
+	return map[string]hexutil.Uint{
+		"pending": hexutil.Uint(coarse(pending)),
+		"queued":  hexutil.Uint(coarse(queue)),
+	}
+}
+
+// txpoolContentAPI serves the transactions of the pool, like PoolContent.
+type txpoolContentAPI struct {
+	n *node.Node
+}
+
+// Content returns the pending and queued transactions by account and nonce.
+func (api *txpoolContentAPI) Content() map[string]map[string]map[string]*rpcTransaction {
+	pending, queue := api.n.TxPool().Content()
+	content := map[string]map[string]map[string]*rpcTransaction{
+		"pending": make(map[string]map[string]*rpcTransaction, len(pending)),
+		"queued":  make(map[string]map[string]*rpcTransaction, len(queue)),
+	}
+	for name, accounts := range map[string]map[common.Address]types.Transactions{"pending": pending, "queued": queue} {
+		for account, txs := range accounts {
+			dump := make(map[string]*rpcTransaction, len(txs))
+			for _, tx := range txs {
+				dump[fmt.Sprintf("%d", tx.Nonce())] = newRPCTransaction(tx, account)
+			}
+			content[name][account.Hex()] = dump
+		}
+	}
+	return content
+}
+
+// Inspect returns a summary of the pending and queued transactions by account
+// and nonce.
+func (api *txpoolContentAPI) Inspect() map[string]map[string]map[string]string {
+	pending, queue := api.n.TxPool().Content()
+	content := map[string]map[string]map[string]string{
+		"pending": make(map[string]map[string]string, len(pending)),
+		"queued":  make(map[string]map[string]string, len(queue)),
+	}
+	for name, accounts := range map[string]map[common.Address]types.Transactions{"pending": pending, "queued": queue} {
+		for account, txs := range accounts {
+			dump := make(map[string]string, len(txs))
+			for _, tx := range txs {
+				to := "contract creation"
+				if tx.To() != nil {
+					to = tx.To().Hex()
+				}
+				dump[fmt.Sprintf("%d", tx.Nonce())] = fmt.Sprintf("%s: %v wei + %v gas × %v wei", to, tx.Value(), tx.Gas(), tx.GasPrice())
+			}
+			content[name][account.Hex()] = dump
+		}
+	}
+	return content
+}
+
+// trustedAPI adds encrypted transactions to the pool as remotes, like
+// AddRemoteTrustedTxs.
+type trustedAPI struct {
+	s *TrustedService
+}
+
+// SendEncryptedTransaction decrypts the transaction with the node key, adds it
+// to the pool and returns its hash.
+func (api *trustedAPI) SendEncryptedTransaction(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	txs, errs := api.s.parseCryptedTxsTransactions(&trusted.AddTrustedTxsRequest{CtyptedTxs: [][]byte{input}})
+	if err := api.s.addTxs(txs, errs, false)[0]; err != nil {
+		return common.Hash{}, err
+	}
+	return txs[0].Hash(), nil
+}
+
+// rpcTransaction is a pool transaction in the JSON-RPC format, it isn't in a
+// block yet.
+type rpcTransaction struct {
+	BlockHash        *common.Hash      `json:"blockHash"`
+	BlockNumber      *hexutil.Big      `json:"blockNumber"`
+	From             common.Address    `json:"from"`
+	Gas              hexutil.Uint64    `json:"gas"`
+	GasPrice         *hexutil.Big      `json:"gasPrice"`
+	GasFeeCap        *hexutil.Big      `json:"maxFeePerGas,omitempty"`
+	GasTipCap        *hexutil.Big      `json:"maxPriorityFeePerGas,omitempty"`
+	Hash             common.Hash       `json:"hash"`
+	Input            hexutil.Bytes     `json:"input"`
+	Nonce            hexutil.Uint64    `json:"nonce"`
+	To               *common.Address   `json:"to"`
+	TransactionIndex *hexutil.Uint64   `json:"transactionIndex"`
+	Value            *hexutil.Big      `json:"value"`
+	Type             hexutil.Uint64    `json:"type"`
+	Accesses         *types.AccessList `json:"accessList,omitempty"`
+	ChainID          *hexutil.Big      `json:"chainId,omitempty"`
+	V                *hexutil.Big      `json:"v"`
+	R                *hexutil.Big      `json:"r"`
+	S                *hexutil.Big      `json:"s"`
+}
+
+func newRPCTransaction(tx *types.Transaction, from common.Address) *rpcTransaction {
+	v, r, s := tx.RawSignatureValues()
+	result := &rpcTransaction{
+		From:     from,
+		Gas:      hexutil.Uint64(tx.Gas()),
+		GasPrice: (*hexutil.Big)(tx.GasPrice()),
+		Hash:     tx.Hash(),
+		Input:    hexutil.Bytes(tx.Data()),
+		Nonce:    hexutil.Uint64(tx.Nonce()),
+		To:       tx.To(),
+		Value:    (*hexutil.Big)(tx.Value()),
+		Type:     hexutil.Uint64(tx.Type()),
+		V:        (*hexutil.Big)(v),
+		R:        (*hexutil.Big)(r),
+		S:        (*hexutil.Big)(s),
+	}
+	if tx.Type() != types.LegacyTxType {
+		al := tx.AccessList()
+		result.Accesses = &al
+		result.ChainID = (*hexutil.Big)(tx.ChainId())
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		result.GasFeeCap = (*hexutil.Big)(tx.GasFeeCap())
+		result.GasTipCap = (*hexutil.Big)(tx.GasTipCap())
+	}
+	return result
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
+	"net/http"
+)
+
+const (
+	sseContentType = "text/event-stream"
+
+	// gatewayBufferSize is the buffer size of the in-process connection from
+	// the gateway to the grpc server.
+	gatewayBufferSize = 256 * 1024
+)
+
+// sseJSON writes stream messages as server-sent events, for clients asking
+// for text/event-stream like browser EventSources. Each message is a data
+// event, an error ending the stream is an error event.
+type sseJSON struct {
+	hexJSON
+}
+
+func (sseJSON) ContentType() string {
+	return sseContentType
+}
+
+func (m sseJSON) Marshal(v interface{}) ([]byte, error) {
+	var (
+		buf     bytes.Buffer
+		isError bool
+	)
+	switch chunk := v.(type) {
+	case map[string]interface{}:
+		if result, ok := chunk["result"]; ok {
+			v = result
+		}
+	case map[string]proto.Message:
+		v, isError = chunk["error"]
+	}
+	data, err := m.hexJSON.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if isError {
+		// The error ends the stream, it isn't followed by a delimiter
+		fmt.Fprintf(&buf, "event: error\ndata: %s\n\n", data)
+	} else {
+		fmt.Fprintf(&buf, "data: %s", data)
+	}
+	return buf.Bytes(), nil
+}
+
+// Delimiter ends an event.
+func (sseJSON) Delimiter() []byte {
+	return []byte("\n\n")
+}
+
+// sseStart sends the headers of an event stream right away, so clients see it
+// open before the first event.
+func sseStart(ctx context.Context, w http.ResponseWriter, resp proto.Message) error {
+	if resp == nil && w.Header().Get("Content-Type") == sseContentType {
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(http.StatusOK)
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+	}
+	return nil
+}
+
+// gateway serves TrustedService as REST/JSON over http. Requests are passed
+// to the grpc server over an in-process connection, so they go through the
+// same authentication. Callers authenticate with tokens, client certificates
+// of the http connection are not passed on.
+type gateway struct {
+	server *http.Server
+	addr   net.Addr
+	lis    *bufconn.Listener // In-process listener of the grpc server
+	conn   *grpc.ClientConn
+}
+
+// startGateway serves the gateway on the port and the in-process listener on
+// the grpc server. With a TLS config the gateway serves https.
+func startGateway(server *grpc.Server, port int, tlsConfig *tls.Config) (*gateway, error) {
+	g := &gateway{lis: bufconn.Listen(gatewayBufferSize)}
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return g.lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(selfCredentials(tlsConfig)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	g.conn = conn
+
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, hexJSON{}),
+		runtime.WithMarshalerOption(sseContentType, sseJSON{}),
+		runtime.WithForwardResponseOption(sseStart),
+	)
+	if err := trusted.RegisterTrustedServiceHandler(context.Background(), mux, conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to listen: %v", err)
+	}
+	if tlsConfig != nil {
+		lis = tls.NewListener(lis, tlsConfig.Clone())
+	}
+	g.server = &http.Server{Handler: mux}
+	g.addr = lis.Addr()
+
+	go server.Serve(g.lis)
+	go func() {
+		if err := g.server.Serve(lis); !errors.Is(err, http.ErrServerClosed) {
+			log.WithField("err", err).Error("gateway failed")
+		}
+	}()
+	log.WithField("addr", g.addr.String()).Info("gateway started")
+	return g, nil
+}
+
+// shutdown stops accepting requests and waits for the ones in flight.
+func (g *gateway) shutdown(ctx context.Context) {
+	g.server.Shutdown(ctx)
+	g.conn.Close()
+}
+
+// close ends the requests in flight, event streams included.
+func (g *gateway) close() {
+	g.server.Close()
+	g.conn.Close()
+}
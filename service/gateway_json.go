@@ -0,0 +1,342 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gogo/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"io"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// quantityFields are the bytes fields holding big-endian integers, they are
+// encoded as hex quantities like in eth JSON-RPC.
+var quantityFields = map[reflect.Type]string{
+	reflect.TypeOf(trusted.SetPriceRequest{}):  "Price",
+	reflect.TypeOf(trusted.GasPriceResponse{}): "Price",
+	reflect.TypeOf(trusted.PoolQueryRequest{}): "MinTip",
+}
+
+// hexJSON encodes the messages of the gateway as JSON with the field names of
+// the proto JSON mapping. Bytes are 0x prefixed hex, so addresses, hashes and
+// transactions read like in eth JSON-RPC, and big ints are hex quantities.
+// Enums are their value names and durations strings like "1.5s". All fields
+// are written, also when they hold the default value.
+type hexJSON struct{}
+
+var _ runtime.Marshaler = hexJSON{}
+
+func (hexJSON) ContentType() string {
+	return "application/json"
+}
+
+func (hexJSON) Marshal(v interface{}) ([]byte, error) {
+	out, err := encodeJSON(reflect.ValueOf(v), protoField{})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(out)
+}
+
+func (m hexJSON) Unmarshal(data []byte, v interface{}) error {
+	return m.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+func (hexJSON) NewDecoder(r io.Reader) runtime.Decoder {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	return runtime.DecoderFunc(func(v interface{}) error {
+		var in interface{}
+		if err := dec.Decode(&in); err != nil {
+			return err
+		}
+		target := reflect.ValueOf(v)
+		if target.Kind() != reflect.Ptr || target.IsNil() {
+			return fmt.Errorf("decode into %T", v)
+		}
+		return decodeJSON(in, target.Elem(), protoField{})
+	})
+}
+
+func (m hexJSON) NewEncoder(w io.Writer) runtime.Encoder {
+	return runtime.EncoderFunc(func(v interface{}) error {
+		data, err := m.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	})
+}
+
+// protoField is a field of a generated message and its JSON names.
+type protoField struct {
+	index    int
+	jsonName string
+	origName string
+	quantity bool
+	enum     string // name of the enum type, registered with its values
+}
+
+// protoFields returns the fields of a generated message, the ones without a
+// protobuf tag are internal to the generator.
+func protoFields(t reflect.Type) []protoField {
+	var fields []protoField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("protobuf")
+		if f.PkgPath != "" || tag == "" {
+			continue
+		}
+		field := protoField{index: i, quantity: quantityFields[t] == f.Name}
+		for _, part := range strings.Split(tag, ",") {
+			switch {
+			case strings.HasPrefix(part, "name="):
+				field.origName = strings.TrimPrefix(part, "name=")
+			case strings.HasPrefix(part, "json="):
+				field.jsonName = strings.TrimPrefix(part, "json=")
+			case strings.HasPrefix(part, "enum="):
+				field.enum = strings.TrimPrefix(part, "enum=")
+			}
+		}
+		if field.jsonName == "" {
+			field.jsonName = field.origName
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// encodeJSON converts the value of the field to what encoding/json writes as
+// wanted.
+func encodeJSON(v reflect.Value, f protoField) (interface{}, error) {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil, nil
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		switch value := v.Interface().(type) {
+		case *anypb.Any:
+			return encodeAny(value)
+		case *durationpb.Duration:
+			return value.AsDuration().String(), nil
+		}
+		return encodeJSON(v.Elem(), f)
+	case reflect.Struct:
+		out := make(map[string]interface{})
+		for _, f := range protoFields(v.Type()) {
+			value, err := encodeJSON(v.Field(f.index), f)
+			if err != nil {
+				return nil, err
+			}
+			out[f.jsonName] = value
+		}
+		return out, nil
+	case reflect.Map:
+		out := make(map[string]interface{}, v.Len())
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+		for _, key := range keys {
+			value, err := encodeJSON(v.MapIndex(key), protoField{})
+			if err != nil {
+				return nil, err
+			}
+			out[fmt.Sprint(key)] = value
+		}
+		return out, nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			if f.quantity {
+				return hexutil.EncodeBig(new(big.Int).SetBytes(v.Bytes())), nil
+			}
+			return hexutil.Encode(v.Bytes()), nil
+		}
+		out := make([]interface{}, v.Len())
+		for i := range out {
+			value, err := encodeJSON(v.Index(i), f)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = value
+		}
+		return out, nil
+	case reflect.Int32:
+		if f.enum != "" {
+			return fmt.Sprint(v.Interface()), nil
+		}
+		return v.Interface(), nil
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return v.Interface(), nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", v.Type())
+}
+
+// encodeAny converts a status detail to its message with the type, like the
+// proto JSON mapping.
+func encodeAny(detail *anypb.Any) (interface{}, error) {
+	msg, err := detail.UnmarshalNew()
+	if err != nil {
+		return map[string]interface{}{"@type": detail.TypeUrl, "value": hexutil.Encode(detail.Value)}, nil
+	}
+	out, err := encodeJSON(reflect.ValueOf(msg), protoField{})
+	if err != nil {
+		return nil, err
+	}
+	if obj, ok := out.(map[string]interface{}); ok {
+		obj["@type"] = detail.TypeUrl
+	}
+	return out, nil
+}
+
+// decodeJSON sets the value of the field from the decoded JSON, the inverse of
+// encodeJSON. Unknown fields are rejected, integers may also be given as
+// strings and enums as numbers.
+func decodeJSON(in interface{}, v reflect.Value, f protoField) error {
+	if in == nil {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.Type() == reflect.TypeOf((*durationpb.Duration)(nil)) {
+			s, ok := in.(string)
+			if !ok {
+				return fmt.Errorf("want duration string like \"1.5s\"")
+			}
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return err
+			}
+			v.Set(reflect.ValueOf(durationpb.New(d)))
+			return nil
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return decodeJSON(in, v.Elem(), f)
+	case reflect.Struct:
+		obj, ok := in.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("want object for %s", v.Type().Name())
+		}
+		fields := protoFields(v.Type())
+		for name, value := range obj {
+			field := -1
+			for i, f := range fields {
+				if name == f.jsonName || name == f.origName {
+					field = i
+					break
+				}
+			}
+			if field < 0 {
+				return fmt.Errorf("unknown field %q of %s", name, v.Type().Name())
+			}
+			if err := decodeJSON(value, v.Field(fields[field].index), fields[field]); err != nil {
+				return fmt.Errorf("%s: %v", name, err)
+			}
+		}
+		return nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			s, ok := in.(string)
+			if !ok {
+				return fmt.Errorf("want hex string")
+			}
+			if f.quantity {
+				n, err := hexutil.DecodeBig(s)
+				if err != nil {
+					return err
+				}
+				v.SetBytes(n.Bytes())
+				return nil
+			}
+			b, err := hexutil.Decode(s)
+			if err != nil {
+				return err
+			}
+			v.SetBytes(b)
+			return nil
+		}
+		list, ok := in.([]interface{})
+		if !ok {
+			return fmt.Errorf("want array")
+		}
+		out := reflect.MakeSlice(v.Type(), len(list), len(list))
+		for i, value := range list {
+			if err := decodeJSON(value, out.Index(i), f); err != nil {
+				return fmt.Errorf("index %d: %v", i, err)
+			}
+		}
+		v.Set(out)
+		return nil
+	case reflect.Bool:
+		b, ok := in.(bool)
+		if !ok {
+			return fmt.Errorf("want bool")
+		}
+		v.SetBool(b)
+		return nil
+	case reflect.String:
+		s, ok := in.(string)
+		if !ok {
+			return fmt.Errorf("want string")
+		}
+		v.SetString(s)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := parseUint(in, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if name, ok := in.(string); ok && f.enum != "" {
+			value, ok := proto.EnumValueMap(f.enum)[name]
+			if !ok {
+				return fmt.Errorf("unknown %s value %q", f.enum, name)
+			}
+			v.SetInt(int64(value))
+			return nil
+		}
+		n, err := strconv.ParseInt(fmt.Sprint(in), 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+		return nil
+	}
+	return fmt.Errorf("unsupported type %s", v.Type())
+}
+
+// parseUint parses a JSON number, or a decimal or hex string.
+func parseUint(in interface{}, bits int) (uint64, error) {
+	var s string
+	switch in := in.(type) {
+	case json.Number:
+		s = in.String()
+	case string:
+		s = in
+	default:
+		return 0, fmt.Errorf("want integer")
+	}
+	if strings.HasPrefix(s, "0x") {
+		return strconv.ParseUint(s[2:], 16, bits)
+	}
+	return strconv.ParseUint(s, 10, bits)
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"io"
+	"net"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHexJSON(t *testing.T) {
+	addr := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	msg := &trusted.PoolContentResponse{
+		PendingList: []*trusted.AccountTransactionList{{
+			Address: addr.Bytes(),
+			TxList:  &trusted.TransactionList{Txs: [][]byte{{0x01, 0x02}, {}}},
+		}},
+	}
+	data, err := hexJSON{}.Marshal(msg)
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	want := `{"crypted":"0x","pendingList":[{"address":"0x00000000000000000000000000000000000000aa","txList":{"txs":["0x0102","0x"]}}],"queueList":[]}`
+	if string(data) != want {
+		t.Errorf("json mismatch:\nhave %s\nwant %s", data, want)
+	}
+	decoded := new(trusted.PoolContentResponse)
+	if err := (hexJSON{}).Unmarshal(data, decoded); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if !reflect.DeepEqual(decoded.PendingList, msg.PendingList) {
+		t.Errorf("round trip mismatch: have %v, want %v", decoded.PendingList, msg.PendingList)
+	}
+
+	// Big ints are quantities, integers may be given as strings
+	price := new(trusted.SetPriceRequest)
+	if err := (hexJSON{}).Unmarshal([]byte(`{"price":"0x3b9aca00"}`), price); err != nil || len(price.Price) != 4 {
+		t.Errorf("quantity not decoded: %x, err %v", price.Price, err)
+	}
+	if data, _ := (hexJSON{}).Marshal(&trusted.GasPriceResponse{Price: []byte{0x00, 0x10}}); string(data) != `{"price":"0x10"}` {
+		t.Errorf("quantity mismatch: have %s", data)
+	}
+	fill := new(trusted.FillBlockRequest)
+	if err := (hexJSON{}).Unmarshal([]byte(`{"parent_hash":"0x01","timestamp":"1700000000"}`), fill); err != nil || fill.Timestamp != 1700000000 {
+		t.Errorf("integer string not decoded: %d, err %v", fill.Timestamp, err)
+	}
+
+	// Enums are value names, numbers are accepted too
+	res := &trusted.AddTxsResponse{Errors: []*trusted.TxError{{}, {Code: trusted.TxErrorCode_TX_ERROR_UNDERPRICED, Message: "transaction underpriced"}}}
+	if data, _ := (hexJSON{}).Marshal(res); string(data) != `{"errors":[{"code":"TX_ERROR_NONE","message":""},{"code":"TX_ERROR_UNDERPRICED","message":"transaction underpriced"}]}` {
+		t.Errorf("enum mismatch: have %s", data)
+	}
+	decodedRes := new(trusted.AddTxsResponse)
+	if err := (hexJSON{}).Unmarshal([]byte(`{"errors":[{"code":"TX_ERROR_MALFORMED"},{"code":7}]}`), decodedRes); err != nil ||
+		decodedRes.Errors[0].Code != trusted.TxErrorCode_TX_ERROR_MALFORMED || decodedRes.Errors[1].Code != trusted.TxErrorCode_TX_ERROR_POOL_OVERFLOW {
+		t.Errorf("enum not decoded: %v, err %v", decodedRes.Errors, err)
+	}
+	if err := (hexJSON{}).Unmarshal([]byte(`{"errors":[{"code":"TX_ERROR_UNKNOWN"}]}`), decodedRes); err == nil {
+		t.Error("unknown enum value accepted")
+	}
+
+	// Durations are strings
+	sub := new(trusted.SubscribeNewTxRequest)
+	if err := (hexJSON{}).Unmarshal([]byte(`{"flushInterval":"1.5s","maxBatch":10}`), sub); err != nil || sub.FlushInterval.AsDuration() != 1500*time.Millisecond {
+		t.Errorf("duration not decoded: %v, err %v", sub.FlushInterval, err)
+	}
+	if data, _ := (hexJSON{}).Marshal(sub); !strings.Contains(string(data), `"flushInterval":"1.5s"`) {
+		t.Errorf("duration mismatch: have %s", data)
+	}
+
+	// Status details are their message
+	detail, _ := anypb.New(&errdetails.ErrorInfo{Reason: "TX_ERROR_MALFORMED", Domain: errorDomain})
+	if data, _ := (hexJSON{}).Marshal(detail); string(data) != `{"@type":"type.googleapis.com/google.rpc.ErrorInfo","domain":"trusted.v1","metadata":{},"reason":"TX_ERROR_MALFORMED"}` {
+		t.Errorf("detail mismatch: have %s", data)
+	}
+
+	for _, bad := range []string{`{"address":"aa"}`, `{"address":"0xa"}`, `{"addr":"0xaa"}`, `{"address":1}`} {
+		if err := (hexJSON{}).Unmarshal([]byte(bad), new(trusted.PendingNonceRequest)); err == nil {
+			t.Errorf("invalid json %s accepted", bad)
+		}
+	}
+}
+
+type testGatewayService struct {
+	trusted.UnimplementedTrustedServiceServer
+	txs chan [][]byte
+}
+
+func (s *testGatewayService) PoolGasPrice(ctx context.Context, req *emptypb.Empty) (*trusted.GasPriceResponse, error) {
+	return &trusted.GasPriceResponse{Price: []byte{0x3b, 0x9a, 0xca, 0x00}}, nil
+}
+
+func (s *testGatewayService) PendingNonce(ctx context.Context, req *trusted.PendingNonceRequest) (*trusted.PendingNonceResponse, error) {
+	if len(req.Address) != common.AddressLength {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	return &trusted.PendingNonceResponse{Nonce: uint64(req.Address[19])}, nil
+}
+
+func (s *testGatewayService) SubscribeNewTransaction(req *trusted.SubscribeNewTxRequest, server trusted.TrustedService_SubscribeNewTransactionServer) error {
+	if err := server.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+	for seq := uint64(1); ; seq++ {
+		select {
+		case <-server.Context().Done():
+			return nil
+		case txs, ok := <-s.txs:
+			if !ok {
+				return status.Error(codes.Aborted, "subscription ended")
+			}
+			if err := server.Send(&trusted.SubscribeNewTxResponse{CryptedNewTx: txs, Sequence: seq}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func startTestGateway(t *testing.T) (*testGatewayService, string) {
+	server := grpc.NewServer()
+	svc := &testGatewayService{txs: make(chan [][]byte)}
+	trusted.RegisterTrustedServiceServer(server, svc)
+	g, err := startGateway(server, 0, nil)
+	if err != nil {
+		t.Fatalf("start gateway failed: %v", err)
+	}
+	t.Cleanup(func() {
+		g.close()
+		server.Stop()
+	})
+	return svc, fmt.Sprintf("http://127.0.0.1:%d", g.addr.(*net.TCPAddr).Port)
+}
+
+func TestGateway(t *testing.T) {
+	_, url := startTestGateway(t)
+
+	tests := []struct {
+		method, path, body string
+		code               int
+		want               string
+	}{
+		{"GET", "/v1/pool/price", "", http.StatusOK, `{"price":"0x3b9aca00"}`},
+		{"POST", "/v1/pool/nonce", `{"address":"0x00000000000000000000000000000000000000aa"}`, http.StatusOK, `"nonce":170}`},
+		{"POST", "/v1/pool/nonce", `{"address":"0xaa"}`, http.StatusBadRequest, `"invalid address"`},
+		{"POST", "/v1/pool/nonce", `{"address":"aa"}`, http.StatusBadRequest, `without 0x prefix`},
+		{"GET", "/v1/ready", "", http.StatusNotImplemented, `"code":12`},
+	}
+	for _, tt := range tests {
+		req, _ := http.NewRequest(tt.method, url+tt.path, strings.NewReader(tt.body))
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("%s %s failed: %v", tt.method, tt.path, err)
+		}
+		body, _ := io.ReadAll(res.Body)
+		res.Body.Close()
+		if res.StatusCode != tt.code || !strings.Contains(string(body), tt.want) {
+			t.Errorf("%s %s mismatch: have %d %s, want %d %s", tt.method, tt.path, res.StatusCode, body, tt.code, tt.want)
+		}
+	}
+}
+
+func TestGatewayEventStream(t *testing.T) {
+	svc, url := startTestGateway(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, "GET", url+"/v1/txs/subscribe", nil)
+	req.Header.Set("Accept", sseContentType)
+	// The stream opens before the first event
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	defer res.Body.Close()
+	if ct := res.Header.Get("Content-Type"); ct != sseContentType {
+		t.Fatalf("content type mismatch: have %s, want %s", ct, sseContentType)
+	}
+	go func() {
+		svc.txs <- [][]byte{{0x01, 0x02}}
+		svc.txs <- [][]byte{{0x03}}
+		close(svc.txs)
+	}()
+	r := bufio.NewReader(res.Body)
+	readEvent := func() string {
+		var event []string
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				t.Fatalf("read event failed: %v", err)
+			}
+			if line == "\n" {
+				return strings.Join(event, "")
+			}
+			event = append(event, line)
+		}
+	}
+	for _, want := range []string{
+		"data: {\"cryptedNewTx\":[\"0x0102\"],\"sequence\":1}\n",
+		"data: {\"cryptedNewTx\":[\"0x03\"],\"sequence\":2}\n",
+	} {
+		if event := readEvent(); event != want {
+			t.Errorf("event mismatch: have %q, want %q", event, want)
+		}
+	}
+	if event := readEvent(); !strings.HasPrefix(event, "event: error\ndata: ") || !strings.Contains(event, "subscription ended") {
+		t.Errorf("error event mismatch: have %q", event)
+	}
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"github.com/trusted-defi/trusted-engine/node"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"strings"
+	"time"
+)
+
+const (
+	// ReadinessService is the health service name reporting whether the node
+	// can serve requests. The empty service name reports liveness only.
+	ReadinessService = "readiness"
+
+	healthCheckInterval = time.Second
+)
+
+// registerHealth registers the standard grpc health service. Liveness is always
+// serving while the server runs, readiness and the trusted service follow the
+// node readiness until quit is closed.
+func registerHealth(server *grpc.Server, n *node.Node, quit <-chan struct{}) *health.Server {
+	hs := health.NewServer()
+	hs.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, hs)
+
+	update := func(status healthpb.HealthCheckResponse_ServingStatus) {
+		hs.SetServingStatus(ReadinessService, status)
+		hs.SetServingStatus(trusted.TrustedService_ServiceDesc.ServiceName, status)
+	}
+	update(healthpb.HealthCheckResponse_NOT_SERVING)
+	go func() {
+		ticker := time.NewTicker(healthCheckInterval)
+		defer ticker.Stop()
+
+		var last string
+		for first := true; ; first = false {
+			reasons := n.Readiness()
+			current := strings.Join(reasons, ", ")
+			if first || current != last {
+				if len(reasons) == 0 {
+					log.Info("trusted service is ready")
+					update(healthpb.HealthCheckResponse_SERVING)
+				} else {
+					log.WithField("reasons", current).Info("trusted service is not ready")
+					update(healthpb.HealthCheckResponse_NOT_SERVING)
+				}
+				last = current
+			}
+			select {
+			case <-ticker.C:
+			case <-quit:
+				return
+			}
+		}
+	}()
+	return hs
+}
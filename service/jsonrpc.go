@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/sirupsen/logrus"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// rpcAPI is a receiver of eth JSON-RPC methods and the role needed to call
+// them, like methodRoles for the grpc methods.
+type rpcAPI struct {
+	namespace string
+	role      Role
+	service   interface{}
+}
+
+// rpcAPIs returns the JSON-RPC methods of the service. Receivers of the same
+// namespace are merged, so callers only see the methods their roles allow, a
+// later receiver replaces the methods of the same name. Privacy mode leaves out
+// the pool content, the pending nonces and the pending transaction hashes.
+func rpcAPIs(s *TrustedService) []rpcAPI {
+	if privacyMode {
+		return []rpcAPI{
+			{"eth", RolePublic, &ethAPI{n: s.n}},
+			{"txpool", RolePublic, &txpoolAPI{s.n}},
+			{"trusted", RolePublic, &trustedAPI{s}},
+		}
+	}
+	return []rpcAPI{
+		{"eth", RolePublic, &ethAPI{n: s.n}},
+		{"eth", RoleAdmin, &ethAPI{n: s.n, pending: true}},
+		{"txpool", RolePublic, &txpoolAPI{s.n}},
+		{"eth", RolePeer, &ethSubscribeAPI{s.n, s.quit}},
+		{"txpool", RoleAdmin, &txpoolContentAPI{s.n}},
+		{"trusted", RolePublic, &trustedAPI{s}},
+	}
+}
+
+// rpcServer serves the eth JSON-RPC methods over http and websocket. With
+// authentication each set of roles gets its own rpc server, holding the
+// methods the roles allow.
+type rpcServer struct {
+	apis    []rpcAPI
+	auth    *authenticator // nil if authentication is disabled
+	origins []string
+
+	mu      sync.Mutex
+	servers map[string]*rpcHandler // by roles of the caller
+	closed  bool
+
+	server *http.Server
+	addr   net.Addr
+}
+
+// rpcHandler is an rpc server and its websocket endpoint.
+type rpcHandler struct {
+	server *rpc.Server
+	ws     http.Handler
+}
+
+// startRPC serves the JSON-RPC server on the port. With a TLS config it
+// serves https and wss.
+func startRPC(apis []rpcAPI, auth *authenticator, port int, origins []string, tlsConfig *tls.Config) (*rpcServer, error) {
+	r := &rpcServer{
+		apis:    apis,
+		auth:    auth,
+		origins: origins,
+		servers: make(map[string]*rpcHandler),
+	}
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen: %v", err)
+	}
+	if tlsConfig != nil {
+		lis = tls.NewListener(lis, tlsConfig.Clone())
+	}
+	r.server = &http.Server{Handler: r}
+	r.addr = lis.Addr()
+	go func() {
+		if err := r.server.Serve(lis); !errors.Is(err, http.ErrServerClosed) {
+			log.WithField("err", err).Error("json-rpc server failed")
+		}
+	}()
+	log.WithField("addr", r.addr.String()).Info("json-rpc server started")
+	return r, nil
+}
+
+func (r *rpcServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	c := &caller{name: "anonymous", roles: map[Role]bool{RoleAdmin: true}}
+	if r.auth != nil {
+		var err error
+		if c, err = r.auth.identifyRequest(req); err != nil {
+			log.WithFields(logrus.Fields{"addr": req.RemoteAddr, "err": err}).Warn("json-rpc request denied")
+			http.Error(w, "unknown token", http.StatusUnauthorized)
+			return
+		}
+	}
+	h, err := r.handler(c)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	if isWebsocket(req) {
+		h.ws.ServeHTTP(w, req)
+		return
+	}
+	h.server.ServeHTTP(w, req)
+}
+
+// handler returns the rpc server holding the methods the caller may call.
+func (r *rpcServer) handler(c *caller) (*rpcHandler, error) {
+	var roles []string
+	for role := range c.roles {
+		roles = append(roles, string(role))
+	}
+	sort.Strings(roles)
+	key := strings.Join(roles, ",")
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil, errors.New("json-rpc server stopped")
+	}
+	if h, ok := r.servers[key]; ok {
+		return h, nil
+	}
+	server := rpc.NewServer()
+	for _, api := range r.apis {
+		if !c.has(api.role) {
+			continue
+		}
+		if err := server.RegisterName(api.namespace, api.service); err != nil {
+			return nil, err
+		}
+	}
+	h := &rpcHandler{server: server, ws: server.WebsocketHandler(r.origins)}
+	r.servers[key] = h
+	return h, nil
+}
+
+// isWebsocket reports whether the request asks to upgrade to a websocket.
+func isWebsocket(req *http.Request) bool {
+	return strings.ToLower(req.Header.Get("Upgrade")) == "websocket" &&
+		strings.Contains(strings.ToLower(req.Header.Get("Connection")), "upgrade")
+}
+
+// stopServers closes the rpc servers, which ends websockets and their
+// subscriptions.
+func (r *rpcServer) stopServers() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	for _, h := range r.servers {
+		h.server.Stop()
+	}
+}
+
+// shutdown stops accepting requests and waits for the http requests in
+// flight, websockets are closed.
+func (r *rpcServer) shutdown(ctx context.Context) {
+	r.server.Shutdown(ctx)
+	r.stopServers()
+}
+
+// close ends the requests in flight.
+func (r *rpcServer) close() {
+	r.server.Close()
+	r.stopServers()
+}
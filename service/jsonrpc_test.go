@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/trusted-defi/trusted-engine/config"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testPublicAPI struct{}
+
+func (testPublicAPI) Ping() string { return "pong" }
+
+func (testPublicAPI) Level() string { return "public" }
+
+type testAdminAPI struct{}
+
+func (testAdminAPI) Secret() string { return "secret" }
+
+func (testAdminAPI) Level() string { return "admin" }
+
+type testSubscribeAPI struct {
+	quit chan struct{}
+}
+
+func (api testSubscribeAPI) Ticks(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, _ := rpc.NotifierFromContext(ctx)
+	sub := notifier.CreateSubscription()
+	go func() {
+		for i := 0; ; i++ {
+			select {
+			case <-sub.Err():
+				return
+			case <-api.quit:
+				return
+			case <-time.After(10 * time.Millisecond):
+				notifier.Notify(sub.ID, i)
+			}
+		}
+	}()
+	return sub, nil
+}
+
+func startTestRPC(t *testing.T) (httpURL, wsURL string) {
+	auth, err := newAuthenticator(config.AuthConfig{
+		Enabled:   true,
+		Anonymous: []string{"public"},
+		Tokens:    []config.AuthToken{{Name: "ops", Token: "secret", Roles: []string{"admin"}}},
+	})
+	if err != nil {
+		t.Fatalf("new authenticator failed: %v", err)
+	}
+	quit := make(chan struct{})
+	apis := []rpcAPI{
+		{"test", RolePublic, testPublicAPI{}},
+		{"test", RoleAdmin, testAdminAPI{}},
+		{"test", RolePublic, testSubscribeAPI{quit}},
+	}
+	r, err := startRPC(apis, auth, 0, nil, nil)
+	if err != nil {
+		t.Fatalf("start json-rpc failed: %v", err)
+	}
+	t.Cleanup(func() {
+		close(quit)
+		r.close()
+	})
+	addr := fmt.Sprintf("127.0.0.1:%d", r.addr.(*net.TCPAddr).Port)
+	return "http://" + addr, "ws://" + addr
+}
+
+func TestJSONRPCRoles(t *testing.T) {
+	url, _ := startTestRPC(t)
+
+	tests := []struct {
+		token  string
+		method string
+		want   string
+	}{
+		{"", "test_ping", "pong"},
+		{"", "test_secret", "does not exist"},
+		{"secret", "test_ping", "pong"},
+		{"secret", "test_secret", "secret"},
+		{"", "test_level", "public"},
+		{"secret", "test_level", "admin"}, // later receivers replace methods
+		{"wrong", "test_ping", "401 Unauthorized"},
+	}
+	for _, tt := range tests {
+		client, err := rpc.DialHTTP(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tt.token != "" {
+			client.SetHeader("Authorization", "Bearer "+tt.token)
+		}
+		var have string
+		if err := client.Call(&have, tt.method); err != nil {
+			have = err.Error()
+		}
+		client.Close()
+		if !strings.Contains(have, tt.want) {
+			t.Errorf("%s with token %q mismatch: have %q, want %q", tt.method, tt.token, have, tt.want)
+		}
+	}
+}
+
+func TestJSONRPCWebsocket(t *testing.T) {
+	_, url := startTestRPC(t)
+
+	client, err := rpc.Dial(url)
+	if err != nil {
+		t.Fatalf("dial websocket failed: %v", err)
+	}
+	defer client.Close()
+	ticks := make(chan int)
+	sub, err := client.Subscribe(context.Background(), "test", ticks, "ticks")
+	if err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	defer sub.Unsubscribe()
+	for i := 0; i < 2; i++ {
+		select {
+		case tick := <-ticks:
+			if tick != i {
+				t.Errorf("tick mismatch: have %d, want %d", tick, i)
+			}
+		case err := <-sub.Err():
+			t.Fatalf("subscription failed: %v", err)
+		case <-time.After(time.Second):
+			t.Fatal("no notification")
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/ecies"
+	"github.com/gogo/protobuf/proto"
+	"github.com/trusted-defi/trusted-engine/core/cryptor"
+	"github.com/trusted-defi/trusted-engine/core/mempool"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// ownerQueryDomain separates owner query signatures from other messages
+	// signed by the same key.
+	ownerQueryDomain = "trusted-engine owner query"
+
+	// maxOwnerQueryWindow is how far ahead a query may expire, it bounds how
+	// long its nonce is remembered.
+	maxOwnerQueryWindow = 5 * time.Minute
+
+	// maxOwnerNonces is how many unexpired nonces of an owner are remembered,
+	// its queries above it are refused until some expire.
+	maxOwnerNonces = 256
+
+	// maxOwners is how many owners with unexpired nonces are remembered.
+	maxOwners = 1 << 16
+)
+
+var errNotOwner = status.Error(codes.PermissionDenied, "query not signed by the owner")
+
+// ownerQueryHash returns the hash the owner signs for a query of the method
+// about the subject, the tx hash or address queried.
+func ownerQueryHash(method string, subject []byte, auth *trusted.OwnerAuth) []byte {
+	var numbers [16]byte
+	binary.BigEndian.PutUint64(numbers[:8], auth.Nonce)
+	binary.BigEndian.PutUint64(numbers[8:], auth.Expiry)
+	digest := crypto.Keccak256([]byte(ownerQueryDomain), []byte(method), []byte{0}, subject, numbers[:], auth.ReplyKey)
+	return accounts.TextHash(digest)
+}
+
+// ownerAuth checks queries signed by the owner of the transactions they ask
+// for, rejecting expired ones and replays of a nonce.
+type ownerAuth struct {
+	mu   sync.Mutex
+	used map[common.Address]map[uint64]uint64 // Expiry of the used nonces by owner
+}
+
+func newOwnerAuth() *ownerAuth {
+	return &ownerAuth{used: make(map[common.Address]map[uint64]uint64)}
+}
+
+// verify checks that the query of the method about the subject is signed by
+// the owner and returns the key to encrypt the response to. The nonce only
+// counts as used once the owner signed it, so others can't use up its nonces.
+func (a *ownerAuth) verify(method string, subject []byte, owner common.Address, auth *trusted.OwnerAuth) (*ecies.PublicKey, error) {
+	if auth == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "%s needs a query signed by the owner", method)
+	}
+	now := uint64(time.Now().Unix())
+	if auth.Expiry < now {
+		return nil, status.Error(codes.Unauthenticated, "query expired")
+	}
+	if auth.Expiry > now+uint64(maxOwnerQueryWindow/time.Second) {
+		return nil, status.Errorf(codes.InvalidArgument, "query expires more than %v ahead", maxOwnerQueryWindow)
+	}
+	replyKey, err := crypto.UnmarshalPubkey(auth.ReplyKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reply key: %v", err)
+	}
+	if len(auth.Signature) != crypto.SignatureLength {
+		return nil, status.Error(codes.InvalidArgument, "invalid signature length")
+	}
+	// Wallets sign with a V of 27 or 28
+	sig := common.CopyBytes(auth.Signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pub, err := crypto.SigToPub(ownerQueryHash(method, subject, auth), sig)
+	if err != nil || crypto.PubkeyToAddress(*pub) != owner {
+		return nil, errNotOwner
+	}
+	if err := a.use(owner, auth.Nonce, auth.Expiry, now); err != nil {
+		return nil, err
+	}
+	return ecies.ImportECDSAPublic(replyKey), nil
+}
+
+// use marks the nonce of the owner used until its expiry.
+func (a *ownerAuth) use(owner common.Address, nonce, expiry, now uint64) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	nonces := a.used[owner]
+	if _, ok := nonces[nonce]; ok {
+		return status.Error(codes.Unauthenticated, "query nonce already used")
+	}
+	// Nonces of expired queries can't be replayed anymore, drop them once full
+	if len(nonces) >= maxOwnerNonces {
+		expireNonces(nonces, now)
+		if len(nonces) >= maxOwnerNonces {
+			return status.Error(codes.ResourceExhausted, "too many queries of the owner, retry later")
+		}
+	}
+	if nonces == nil {
+		if len(a.used) >= maxOwners {
+			for addr, n := range a.used {
+				if expireNonces(n, now); len(n) == 0 {
+					delete(a.used, addr)
+				}
+			}
+			if len(a.used) >= maxOwners {
+				return status.Error(codes.ResourceExhausted, "too many owner queries, retry later")
+			}
+		}
+		nonces = make(map[uint64]uint64)
+		a.used[owner] = nonces
+	}
+	nonces[nonce] = expiry
+	return nil
+}
+
+// expireNonces drops the nonces expired at now.
+func expireNonces(nonces map[uint64]uint64, now uint64) {
+	for nonce, expiry := range nonces {
+		if expiry < now {
+			delete(nonces, nonce)
+		}
+	}
+}
+
+// txSender returns the sender of a pool transaction. Pool transactions are
+// validated, the sender is cached already.
+func txSender(tx *types.Transaction) common.Address {
+	sender, _ := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	return sender
+}
+
+// showsPoolState reports whether a query of the owner, nil if unsigned, may
+// learn the status of the transaction. Included transactions are public, the
+// pool states tell whether the pool has or had the transaction, so only its
+// sender sees them, like with TxGet.
+func showsPoolState(src txStatusSource, owner *common.Address, hash common.Hash, st mempool.TxStatus) bool {
+	switch st {
+	case mempool.TxStatusUnknown, mempool.TxStatusIncluded:
+		return true
+	case mempool.TxStatusQueued, mempool.TxStatusPending:
+		tx := src.Get(hash)
+		return owner != nil && tx != nil && txSender(tx) == *owner
+	default:
+		rec := src.Record(hash)
+		return owner != nil && rec != nil && rec.Sender == *owner
+	}
+}
+
+// sealReply encrypts the response to the reply key of the owner.
+func sealReply(res proto.Message, key *ecies.PublicKey) ([]byte, error) {
+	data, err := proto.Marshal(res)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode response failed: %v", err)
+	}
+	crypted, err := cryptor.Encrypt(data, key)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encrypt response failed: %v", err)
+	}
+	return crypted, nil
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"crypto/ecdsa"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gogo/protobuf/proto"
+	"github.com/trusted-defi/trusted-engine/core/cryptor"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestOwnerAuth(t *testing.T) {
+	owner, _ := crypto.GenerateKey()
+	stranger, _ := crypto.GenerateKey()
+	reply := cryptor.GenerateKey()
+	ownerAddr := crypto.PubkeyToAddress(owner.PublicKey)
+	subject := ownerAddr.Bytes()
+
+	sign := func(key *ecdsa.PrivateKey, method string, nonce uint64, expiry time.Time) *trusted.OwnerAuth {
+		auth := &trusted.OwnerAuth{
+			Nonce:    nonce,
+			Expiry:   uint64(expiry.Unix()),
+			ReplyKey: crypto.FromECDSAPub(reply.PublicKey.ExportECDSA()),
+		}
+		auth.Signature, _ = crypto.Sign(ownerQueryHash(method, subject, auth), key)
+		return auth
+	}
+	a := newOwnerAuth()
+
+	// Queries signed by others are refused before their nonce is used
+	if _, err := a.verify("PendingNonce", subject, ownerAddr, sign(stranger, "PendingNonce", 1, time.Now().Add(time.Minute))); err != errNotOwner {
+		t.Errorf("query of a stranger accepted: %v", err)
+	}
+	if len(a.used) != 0 {
+		t.Errorf("nonce of a stranger remembered: %v", a.used)
+	}
+	key, err := a.verify("PendingNonce", subject, ownerAddr, sign(owner, "PendingNonce", 1, time.Now().Add(time.Minute)))
+	if err != nil {
+		t.Fatalf("verify failed: %v", err)
+	}
+	if !key.ExportECDSA().Equal(reply.PublicKey.ExportECDSA()) {
+		t.Errorf("reply key mismatch")
+	}
+	// Wallet style V is accepted
+	auth := sign(owner, "PendingNonce", 2, time.Now().Add(time.Minute))
+	auth.Signature[crypto.RecoveryIDOffset] += 27
+	if _, err := a.verify("PendingNonce", subject, ownerAddr, auth); err != nil {
+		t.Errorf("wallet signature rejected: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		auth   *trusted.OwnerAuth
+		code   codes.Code
+	}{
+		{"unsigned", "PendingNonce", nil, codes.Unauthenticated},
+		{"replayed", "PendingNonce", sign(owner, "PendingNonce", 1, time.Now().Add(time.Minute)), codes.Unauthenticated},
+		{"expired", "PendingNonce", sign(owner, "PendingNonce", 3, time.Now().Add(-time.Minute)), codes.Unauthenticated},
+		{"too far", "PendingNonce", sign(owner, "PendingNonce", 4, time.Now().Add(time.Hour)), codes.InvalidArgument},
+		{"bad reply key", "PendingNonce", &trusted.OwnerAuth{Nonce: 5, Expiry: uint64(time.Now().Unix() + 60), ReplyKey: []byte{4}}, codes.InvalidArgument},
+		{"bad signature", "PendingNonce", &trusted.OwnerAuth{Nonce: 6, Expiry: uint64(time.Now().Unix() + 60), ReplyKey: crypto.FromECDSAPub(reply.PublicKey.ExportECDSA()), Signature: []byte{1}}, codes.InvalidArgument},
+		{"other method", "PoolContentFrom", sign(owner, "PendingNonce", 7, time.Now().Add(time.Minute)), codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		if _, err := a.verify(tt.method, subject, ownerAddr, tt.auth); status.Code(err) != tt.code {
+			t.Errorf("%s: code mismatch: have %v, want %v", tt.name, err, tt.code)
+		}
+	}
+}
+
+func TestOwnerAuthNonceLimit(t *testing.T) {
+	var (
+		a     = newOwnerAuth()
+		now   = uint64(time.Now().Unix())
+		alice = common.HexToAddress("0xaa")
+		bob   = common.HexToAddress("0xbb")
+	)
+	for i := 0; i < maxOwnerNonces; i++ {
+		if err := a.use(alice, uint64(i), now+60, now); err != nil {
+			t.Fatalf("use %d failed: %v", i, err)
+		}
+	}
+	// An owner above its limit doesn't hold up others
+	if err := a.use(alice, maxOwnerNonces, now+60, now); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("nonce above the owner limit accepted: %v", err)
+	}
+	if err := a.use(bob, 0, now+60, now); err != nil {
+		t.Errorf("other owner refused: %v", err)
+	}
+	// Expired nonces make room
+	if err := a.use(alice, maxOwnerNonces, now+120, now+61); err != nil {
+		t.Errorf("nonce after expiry refused: %v", err)
+	}
+	if len(a.used[alice]) != 1 {
+		t.Errorf("expired nonces kept: have %d", len(a.used[alice]))
+	}
+	// Owners without unexpired nonces are forgotten once full
+	for i := len(a.used); i < maxOwners; i++ {
+		a.used[common.BigToAddress(big.NewInt(int64(i)+0x1000))] = map[uint64]uint64{0: now}
+	}
+	if err := a.use(common.HexToAddress("0xcc"), 0, now+200, now+130); err != nil {
+		t.Errorf("new owner refused: %v", err)
+	}
+	if len(a.used) != 1 {
+		t.Errorf("expired owners kept: have %d, want only the new owner", len(a.used))
+	}
+}
+
+func TestSealReply(t *testing.T) {
+	reply := cryptor.GenerateKey()
+	crypted, err := sealReply(&trusted.PendingNonceResponse{Nonce: 42}, &reply.PublicKey)
+	if err != nil {
+		t.Fatalf("seal failed: %v", err)
+	}
+	data, err := cryptor.Decrypt(crypted, reply)
+	if err != nil {
+		t.Fatalf("decrypt failed: %v", err)
+	}
+	res := new(trusted.PendingNonceResponse)
+	if err := proto.Unmarshal(data, res); err != nil || res.Nonce != 42 {
+		t.Errorf("reply mismatch: have %v, err %v", res, err)
+	}
+	other := cryptor.GenerateKey()
+	if _, err := cryptor.Decrypt(crypted, other); err == nil {
+		t.Error("reply decrypted by another key")
+	}
+}
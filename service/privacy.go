@@ -0,0 +1,9 @@
+//go:build privacy
+
+package service
+
+// Enclave builds for operators who must not see the order flow redact the
+// global pool views, see privacyMode.
+func init() {
+	privacyMode = true
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// privacyMode disables the views of the pool showing the order flow of other
+// accounts and coarsens its statistics. Only builds with the privacy tag set
+// it, so it is part of the enclave measurement and the host can't switch it.
+var privacyMode = false
+
+// privacyGranularity is what pool counts are rounded down to in privacy mode.
+const privacyGranularity = 16
+
+// privateMethods are the TrustedService methods showing the transactions or
+// accounts of others. Privacy mode disables them for every role, the roles are
+// configured by the host operator. TxStatus and WatchTransactions stay, they
+// only show the pool states of a transaction to its sender, see showsPoolState.
+var privateMethods = map[string]bool{
+	"PoolContent":       true,
+	"PoolPending":       true,
+	"PoolLocals":        true,
+	"PoolQuery":         true,
+	"PoolQueryStream":   true,
+	"TxHas":             true,
+	"SubscribeTxEvents": true,
+}
+
+// checkPrivacy refuses the private methods in privacy mode.
+func checkPrivacy(method string) error {
+	if !privacyMode || !strings.HasPrefix(method, trustedMethodPrefix) {
+		return nil
+	}
+	if name := strings.TrimPrefix(method, trustedMethodPrefix); privateMethods[name] {
+		return status.Errorf(codes.PermissionDenied, "%s is disabled in privacy mode, PoolStat has the aggregate counts", name)
+	}
+	return nil
+}
+
+func privacyUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := checkPrivacy(info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func privacyStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := checkPrivacy(info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// checkSubscribeFilter refuses subscriptions to the transactions of given
+// accounts in privacy mode, their batches would tell when those accounts
+// trade even though the transactions are encrypted.
+func checkSubscribeFilter(req *trusted.SubscribeNewTxRequest) error {
+	if privacyMode && (len(req.Senders) > 0 || len(req.Recipients) > 0) {
+		return status.Error(codes.PermissionDenied, "sender and recipient filters are disabled in privacy mode")
+	}
+	return nil
+}
+
+// statGranularity returns what pool counts are rounded down to.
+func statGranularity() int {
+	if privacyMode {
+		return privacyGranularity
+	}
+	return 1
+}
+
+// coarse rounds a pool count down to the stat granularity.
+func coarse(n int) int {
+	return n / statGranularity() * statGranularity()
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/trusted-defi/trusted-engine/core/mempool"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestPrivacyMode(t *testing.T) {
+	defer func(mode bool) { privacyMode = mode }(privacyMode)
+
+	tests := []struct {
+		method  string
+		private bool
+	}{
+		{trustedMethodPrefix + "PoolContent", true},
+		{trustedMethodPrefix + "PoolQueryStream", true},
+		{trustedMethodPrefix + "TxHas", true},
+		{trustedMethodPrefix + "SubscribeTxEvents", true},
+		{trustedMethodPrefix + "PoolStat", false},
+		{trustedMethodPrefix + "TxStatus", false},
+		{trustedMethodPrefix + "WatchTransactions", false},
+		{trustedMethodPrefix + "PoolContentFrom", false},
+		{healthMethodPrefix + "Check", false},
+	}
+	filtered := &trusted.SubscribeNewTxRequest{Senders: [][]byte{make([]byte, 20)}}
+	for _, mode := range []bool{false, true} {
+		privacyMode = mode
+		for _, tt := range tests {
+			err := checkPrivacy(tt.method)
+			if want := mode && tt.private; (status.Code(err) == codes.PermissionDenied) != want {
+				t.Errorf("privacy mode %v: %s refused %v, want %v", mode, tt.method, err, want)
+			}
+		}
+		if err := checkSubscribeFilter(filtered); (err != nil) != mode {
+			t.Errorf("privacy mode %v: sender filter refused %v", mode, err)
+		}
+		if err := checkSubscribeFilter(&trusted.SubscribeNewTxRequest{TxTypes: []uint32{2}}); err != nil {
+			t.Errorf("privacy mode %v: type filter refused: %v", mode, err)
+		}
+	}
+
+	// The status methods left open don't tell others whether the pool has a
+	// transaction, nor what replaced it
+	privacyMode = true
+	tx := signedTx(t, 1, 0)
+	src := &testStatusSource{
+		status: map[common.Hash]mempool.TxStatus{tx.Hash(): mempool.TxStatusPending},
+		txs:    map[common.Hash]*types.Transaction{tx.Hash(): tx},
+	}
+	watch := newTxWatch([]common.Hash{tx.Hash()}, 0, nil)
+	if events := watch.load(src); events[0].Status != trusted.TxWatchStatus_TX_WATCH_UNKNOWN {
+		t.Errorf("pending transaction shown to an unsigned watch: %v", events[0])
+	}
+	replaced := mempool.TxEvent{Kind: mempool.TxReplaced, Tx: tx, Sender: crypto.PubkeyToAddress(testOwnerKey.PublicKey), ReplacedBy: signedTx(t, 1, 1)}
+	if event := watch.apply(replaced); event != nil {
+		t.Errorf("replacement shown to an unsigned watch: %v", event)
+	}
+	if showsPoolState(src, nil, tx.Hash(), mempool.TxStatusPending) {
+		t.Error("pending status shown to an unsigned query")
+	}
+
+	privacyMode = false
+	if n := coarse(37); n != 37 || statGranularity() != 1 {
+		t.Errorf("count changed without privacy mode: %d", n)
+	}
+	privacyMode = true
+	if n := coarse(37); n != 32 || statGranularity() != privacyGranularity {
+		t.Errorf("coarse count mismatch: have %d, want 32", n)
+	}
+}
@@ -2,11 +2,12 @@ package service
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/ecies"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/sirupsen/logrus"
 	"github.com/trusted-defi/trusted-engine/blockfill"
@@ -17,6 +18,11 @@ import (
 	"github.com/trusted-defi/trusted-engine/node"
 	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"math/big"
 	"net"
@@ -27,6 +33,9 @@ var log = logrus.WithField("prefix", "service")
 type TrustedService struct {
 	n           *node.Node
 	blockFiller *blockfill.BlockFiller
+	txs         *txStream
+	owners      *ownerAuth
+	quit        <-chan struct{} // Closed when the server stops, ends the subscriptions
 	trusted.UnimplementedTrustedServiceServer
 }
 
@@ -46,29 +55,56 @@ func parseHashs(hashs_data [][]byte) []common.Hash {
 	return hashs
 }
 
-func parseErrs(errs []error) []string {
-	strs := make([]string, 0, len(errs))
+func parseTxErrors(errs []error) []*trusted.TxError {
+	txErrs := make([]*trusted.TxError, 0, len(errs))
 	for _, err := range errs {
-		if err == nil {
-			strs = append(strs, "")
-		} else {
-			strs = append(strs, err.Error())
-		}
+		txErrs = append(txErrs, newTxError(err))
 	}
-	return strs
+	return txErrs
 }
 
 func toBigInt(data []byte) *big.Int {
 	return new(big.Int).SetBytes(data)
 }
 
-func parseListToTransactions(list *trusted.TransactionList) []*types.Transaction {
-	txs := make([]*types.Transaction, 0, len(list.Txs))
-	for _, txdata := range list.Txs {
-		tx := corecmn.ParseTxData(txdata)
-		txs = append(txs, tx)
+// parseListToTransactions decodes the transactions of the list, the ones
+// failing to decode are nil and have an error.
+func parseListToTransactions(list *trusted.TransactionList) ([]*types.Transaction, []error) {
+	txs := make([]*types.Transaction, len(list.GetTxs()))
+	errs := make([]error, len(txs))
+	for i, txdata := range list.GetTxs() {
+		tx, err := corecmn.ParseTxData(txdata)
+		if err != nil {
+			errs[i] = fmt.Errorf("%w: %v", errTxMalformed, err)
+			continue
+		}
+		txs[i] = tx
+	}
+	return txs, errs
+}
+
+// addTxs adds the decoded transactions to the pool and returns the error of
+// each, the ones failing to decode keep their error and never reach the pool.
+func (s *TrustedService) addTxs(txs []*types.Transaction, errs []error, local bool) []error {
+	valid := make([]*types.Transaction, 0, len(txs))
+	for i, tx := range txs {
+		if errs[i] == nil {
+			valid = append(valid, tx)
+		}
+	}
+	var addErrs []error
+	if local {
+		addErrs = s.n.TxPool().AddLocals(valid)
+	} else {
+		addErrs = s.n.TxPool().AddRemotes(valid)
+	}
+	for i, j := 0, 0; i < len(txs); i++ {
+		if errs[i] == nil {
+			errs[i] = addErrs[j]
+			j++
+		}
 	}
-	return txs
+	return errs
 }
 
 func sliceToList(account common.Address, txs types.Transactions) *trusted.AccountTransactionList {
@@ -113,13 +149,13 @@ func (s *TrustedService) parseCryptedTxsTransactions(request *trusted.AddTrusted
 
 		txdata, err := s.decrypt(cryptedTx)
 		if err != nil {
-			errs[i] = err
+			errs[i] = fmt.Errorf("%w: %v", errTxDecrypt, err)
 			continue
 		}
 		tx := new(types.Transaction)
 		err = tx.UnmarshalBinary(txdata)
 		if err != nil {
-			errs[i] = err
+			errs[i] = fmt.Errorf("%w: %v", errTxMalformed, err)
 			continue
 		}
 		txs[i] = tx
@@ -130,13 +166,14 @@ func (s *TrustedService) parseCryptedTxsTransactions(request *trusted.AddTrusted
 
 func (s *TrustedService) ServiceReady(ctx context.Context, req *emptypb.Empty) (*trusted.ServiceReadyResponse, error) {
 	res := new(trusted.ServiceReadyResponse)
-	res.Ready = s.n.IsReady()
+	res.Reasons = s.n.Readiness()
+	res.Ready = len(res.Reasons) == 0
 	return res, nil
 }
 
 func (s *TrustedService) PoolSetPrice(ctx context.Context, req *trusted.SetPriceRequest) (*emptypb.Empty, error) {
 	s.n.TxPool().SetGasPrice(toBigInt(req.Price))
-	return nil, nil
+	return new(emptypb.Empty), nil
 }
 
 func (s *TrustedService) PoolGasPrice(ctx context.Context, req *emptypb.Empty) (*trusted.GasPriceResponse, error) {
@@ -145,22 +182,53 @@ func (s *TrustedService) PoolGasPrice(ctx context.Context, req *emptypb.Empty) (
 	return res, nil
 }
 
+// statusOwner checks the optional owner of a status query, returning nil for
+// an unsigned query, which only sees the public states of transactions.
+func (s *TrustedService) statusOwner(method string, owner []byte, auth *trusted.OwnerAuth) (*common.Address, *ecies.PublicKey, error) {
+	if auth == nil {
+		return nil, nil, nil
+	}
+	replyKey, err := s.ownerQuery(method, owner, auth)
+	if err != nil {
+		return nil, nil, err
+	}
+	addr := common.BytesToAddress(owner)
+	return &addr, replyKey, nil
+}
+
+// ownerQuery checks that the query about the address is signed by it and
+// returns the key to encrypt the response to.
+func (s *TrustedService) ownerQuery(method string, address []byte, auth *trusted.OwnerAuth) (*ecies.PublicKey, error) {
+	if len(address) != common.AddressLength {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %x", address)
+	}
+	return s.owners.verify(method, address, common.BytesToAddress(address), auth)
+}
+
 func (s *TrustedService) PendingNonce(ctx context.Context, req *trusted.PendingNonceRequest) (*trusted.PendingNonceResponse, error) {
+	replyKey, err := s.ownerQuery("PendingNonce", req.Address, req.Auth)
+	if err != nil {
+		return nil, err
+	}
 	addr := common.BytesToAddress(req.Address)
 	nonce := s.n.TxPool().Nonce(addr)
 	res := new(trusted.PendingNonceResponse)
-	res.Nonce = nonce
-
+	if res.Crypted, err = sealReply(&trusted.PendingNonceResponse{Nonce: nonce}, replyKey); err != nil {
+		return nil, err
+	}
 	return res, nil
 }
 
 func (s *TrustedService) PoolStat(ctx context.Context, req *emptypb.Empty) (*trusted.PoolStatResponse, error) {
 	res := new(trusted.PoolStatResponse)
 	pending, queue := s.n.TxPool().Stats()
+	pending, queue = coarse(pending), coarse(queue)
 	//log.WithField("pending", pending).WithField("queue", queue).Info("txpool stat")
 	log.WithField("pending", pending).Info("txpool stat")
 	res.Pending = uint64(pending)
 	res.Queue = uint64(queue)
+	res.Accounts = uint64(coarse(s.n.TxPool().Accounts()))
+	res.Granularity = uint64(statGranularity())
 	return res, nil
 }
 
@@ -173,11 +241,19 @@ func (s *TrustedService) PoolContent(ctx context.Context, req *trusted.PoolConte
 	return res, nil
 }
 func (s *TrustedService) PoolContentFrom(ctx context.Context, req *trusted.PoolContentRequest) (*trusted.PoolContentResponse, error) {
+	replyKey, err := s.ownerQuery("PoolContentFrom", req.Address, req.Auth)
+	if err != nil {
+		return nil, err
+	}
 	from := common.BytesToAddress(req.Address)
-	res := new(trusted.PoolContentResponse)
+	content := new(trusted.PoolContentResponse)
 	pendings, queue := s.n.TxPool().ContentFrom(from)
-	res.PendingList = []*trusted.AccountTransactionList{sliceToList(from, pendings)}
-	res.QueueList = []*trusted.AccountTransactionList{sliceToList(from, queue)}
+	content.PendingList = []*trusted.AccountTransactionList{sliceToList(from, pendings)}
+	content.QueueList = []*trusted.AccountTransactionList{sliceToList(from, queue)}
+	res := new(trusted.PoolContentResponse)
+	if res.Crypted, err = sealReply(content, replyKey); err != nil {
+		return nil, err
+	}
 	return res, nil
 }
 
@@ -197,34 +273,87 @@ func (s *TrustedService) PoolLocals(ctx context.Context, req *emptypb.Empty) (*t
 }
 
 func (s *TrustedService) AddLocalsTx(ctx context.Context, req *trusted.AddTxsRequest) (*trusted.AddTxsResponse, error) {
-	txs := parseListToTransactions(req.TxList)
-	errs := s.n.TxPool().AddLocals(txs)
+	txs, errs := parseListToTransactions(req.TxList)
 	res := new(trusted.AddTxsResponse)
-	res.Errors = parseErrs(errs)
+	res.Errors = parseTxErrors(s.addTxs(txs, errs, true))
+	if err := txsStatus(res.Errors); err != nil {
+		return nil, err
+	}
 	return res, nil
 }
 
 func (s *TrustedService) AddRemoteTx(ctx context.Context, req *trusted.AddTxsRequest) (*trusted.AddTxsResponse, error) {
-	txs := parseListToTransactions(req.TxList)
-	errs := s.n.TxPool().AddRemotes(txs)
+	txs, errs := parseListToTransactions(req.TxList)
 	res := new(trusted.AddTxsResponse)
-	res.Errors = parseErrs(errs)
+	res.Errors = parseTxErrors(s.addTxs(txs, errs, false))
+	if err := txsStatus(res.Errors); err != nil {
+		return nil, err
+	}
 	return res, nil
 }
 
 func (s *TrustedService) TxStatus(ctx context.Context, req *trusted.TxStatusRequest) (*trusted.TxStatusResponse, error) {
+	owner, replyKey, err := s.statusOwner("TxStatus", req.Owner, req.Auth)
+	if err != nil {
+		return nil, err
+	}
 	txhashs := parseHashs(req.TxHashs)
 	txstatus := s.n.TxPool().Status(txhashs)
+	for i, hash := range txhashs {
+		if !showsPoolState(s.n.TxPool(), owner, hash, txstatus[i]) {
+			txstatus[i] = mempool.TxStatusUnknown
+		}
+	}
 	res := new(trusted.TxStatusResponse)
 	res.TxStatus = parseTxStatus(txstatus)
-	return res, nil
+	res.Details = make([]*trusted.TxStatusDetail, len(txhashs))
+	for i, hash := range txhashs {
+		res.Details[i] = new(trusted.TxStatusDetail)
+		if txstatus[i] != mempool.TxStatusIncluded && txstatus[i] != mempool.TxStatusDropped {
+			continue
+		}
+		if rec := s.n.TxPool().Record(hash); rec != nil {
+			if rec.Status == mempool.TxStatusIncluded {
+				res.Details[i].BlockHash = rec.BlockHash.Bytes()
+				res.Details[i].BlockNumber = rec.BlockNumber
+			}
+			res.Details[i].Reason = txDropReasons[rec.Reason]
+		}
+	}
+	if replyKey == nil {
+		return res, nil
+	}
+	sealed := new(trusted.TxStatusResponse)
+	if sealed.Crypted, err = sealReply(res, replyKey); err != nil {
+		return nil, err
+	}
+	return sealed, nil
 }
 
 func (s *TrustedService) TxGet(ctx context.Context, req *trusted.TxGetRequest) (*trusted.TxGetResponse, error) {
+	if len(req.TxHash) != common.HashLength {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hash %x", req.TxHash)
+	}
+	// Unknown transactions have no owner, so they fail like the transactions
+	// of others and the query doesn't tell whether the pool has it
 	txhash := common.BytesToHash(req.TxHash)
 	tx := s.n.TxPool().Get(txhash)
+	var sender common.Address
+	if tx != nil {
+		sender = txSender(tx)
+	}
+	replyKey, err := s.owners.verify("TxGet", req.TxHash, sender, req.Auth)
+	if err != nil {
+		return nil, err
+	}
+	txdata, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode transaction failed: %v", err)
+	}
 	res := new(trusted.TxGetResponse)
-	res.Tx, _ = tx.MarshalBinary()
+	if res.Crypted, err = sealReply(&trusted.TxGetResponse{Tx: txdata}, replyKey); err != nil {
+		return nil, err
+	}
 	return res, nil
 }
 func (s *TrustedService) TxHas(ctx context.Context, req *trusted.TxHasRequest) (*trusted.TxHasResponse, error) {
@@ -235,35 +364,34 @@ func (s *TrustedService) TxHas(ctx context.Context, req *trusted.TxHasRequest) (
 }
 
 func (s *TrustedService) SubscribeNewTransaction(req *trusted.SubscribeNewTxRequest, server trusted.TrustedService_SubscribeNewTransactionServer) error {
-	eventCh := make(chan core.NewTxsEvent)
-	sub := s.n.TxPool().SubscribeNewTxsEvent(eventCh)
-	var bcontinue = true
-	for bcontinue {
-		select {
-		case err := <-sub.Err():
-			log.Println("subscribe failed", err)
-			return err
-		case event := <-eventCh:
-			var crypted_tx_data = make([][]byte, 0)
-			for _, tx := range event.Txs {
-				txdata, err := tx.MarshalBinary()
-				if err != nil {
-					continue
-				}
-				crypted, err := s.crypt(txdata)
-				if err != nil {
-					log.Println("crypt tx data failed", err)
-					continue
-				}
-				crypted_tx_data = append(crypted_tx_data, crypted)
-			}
-			response := trusted.SubscribeNewTxResponse{
-				CryptedNewTx: crypted_tx_data,
-			}
-			server.Send(&response)
+	if err := checkSubscribeFilter(req); err != nil {
+		return err
+	}
+	batcher, err := newTxBatcher(req)
+	if err != nil {
+		return err
+	}
+	batcher.baseFee = s.n.TxPool().BaseFee
+	batcher.encode = func(tx *types.Transaction) ([]byte, error) {
+		txdata, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, err
 		}
+		return s.crypt(txdata)
+	}
+	batcher.send = server.Send
+
+	sub, replay, err := s.txs.subscribe(req.ResumeAfter)
+	if err != nil {
+		return err
+	}
+	defer sub.unsubscribe()
+	// Headers tell the caller the subscription is established, the gateway
+	// waits for them before it opens the event stream
+	if err := server.SendHeader(metadata.MD{}); err != nil {
+		return err
 	}
-	return nil
+	return batcher.run(server.Context(), s.quit, sub, replay)
 }
 
 func (s *TrustedService) Crypt(ctx context.Context, req *trusted.CryptRequest) (*trusted.CryptResponse, error) {
@@ -303,28 +431,24 @@ func (s *TrustedService) AddLocalTrustedTxs(ctx context.Context, req *trusted.Ad
 	res := new(trusted.AddTrustedTxsResponse)
 	res.Results = make([]*trusted.AddTrustedTxResult, len(req.CtyptedTxs))
 
-	txs, parsedErrs := s.parseCryptedTxsTransactions(req)
-	addTxErrs := s.n.TxPool().AddLocals(txs)
+	txs, errs := s.parseCryptedTxsTransactions(req)
+	errs = s.addTxs(txs, errs, true)
+	txErrs := parseTxErrors(errs)
 	for i, tx := range txs {
 		result := new(trusted.AddTrustedTxResult)
-		parseErr := parsedErrs[i]
-		addTxErr := addTxErrs[i]
-		if parseErr != nil {
+		result.Error = txErrs[i]
+		if errs[i] != nil {
 			result.Hash = common.Hash{}.Bytes()
 			result.Asset = make([]byte, 0)
-			result.Error = parseErr.Error()
-		} else if addTxErr != nil {
-			result.Hash = common.Hash{}.Bytes()
-			result.Asset = make([]byte, 0)
-			result.Error = addTxErr.Error()
 		} else {
 			result.Hash = tx.Hash().Bytes()
 			result.Asset = generateTxAsset(tx)
-			result.Error = ""
 		}
 		res.Results[i] = result
 	}
-
+	if err := txsStatus(txErrs); err != nil {
+		return nil, err
+	}
 	return res, nil
 }
 
@@ -333,28 +457,24 @@ func (s *TrustedService) AddRemoteTrustedTxs(ctx context.Context, req *trusted.A
 	res.Results = make([]*trusted.AddTrustedTxResult, len(req.CtyptedTxs))
 	log.WithField("remotetx", len(req.CtyptedTxs)).Info("handler add remote trusted tx")
 
-	txs, parsedErrs := s.parseCryptedTxsTransactions(req)
-	addTxErrs := s.n.TxPool().AddRemotes(txs)
+	txs, errs := s.parseCryptedTxsTransactions(req)
+	errs = s.addTxs(txs, errs, false)
+	txErrs := parseTxErrors(errs)
 	for i, tx := range txs {
 		result := new(trusted.AddTrustedTxResult)
-		parseErr := parsedErrs[i]
-		addTxErr := addTxErrs[i]
-		if parseErr != nil {
-			result.Hash = common.Hash{}.Bytes()
-			result.Asset = make([]byte, 0)
-			result.Error = parseErr.Error()
-		} else if addTxErr != nil {
+		result.Error = txErrs[i]
+		if errs[i] != nil {
 			result.Hash = common.Hash{}.Bytes()
 			result.Asset = make([]byte, 0)
-			result.Error = addTxErr.Error()
 		} else {
 			result.Hash = tx.Hash().Bytes()
 			result.Asset = generateTxAsset(tx)
-			result.Error = ""
 		}
 		res.Results[i] = result
 	}
-
+	if err := txsStatus(txErrs); err != nil {
+		return nil, err
+	}
 	return res, nil
 }
 
@@ -369,7 +489,7 @@ func (s *TrustedService) GetAuthData(ctx context.Context, request *trusted.GetAu
 	res := new(trusted.GetAuthDataResponse)
 	res.AuthData, err = s.n.GetKeyManager().GetAuthData(request.GetPeerId())
 	if err != nil {
-		return nil, err
+		return nil, keyStatus(err)
 	}
 	return res, nil
 }
@@ -379,8 +499,7 @@ func (s *TrustedService) VerifyAuth(ctx context.Context, request *trusted.Verify
 	res := new(trusted.VerifyAuthResponse)
 	err = s.n.GetKeyManager().VerifyAuth(request.GetAuthData(), request.GetPeerId())
 	if err != nil {
-		res.Error = err.Error()
-		return res, err
+		return nil, keyStatus(err)
 	}
 	return res, nil
 }
@@ -390,7 +509,7 @@ func (s *TrustedService) GetVerifyData(ctx context.Context, request *trusted.Get
 	res := new(trusted.GetVerifyDataResponse)
 	res.VerifyData, err = s.n.GetKeyManager().GetVerifyData(request.GetPeerId())
 	if err != nil {
-		return nil, err
+		return nil, keyStatus(err)
 	}
 	return res, nil
 }
@@ -400,8 +519,7 @@ func (s *TrustedService) VerifyRemoteVerify(ctx context.Context, request *truste
 	res := new(trusted.VerifyRemoteVerifyResponse)
 	err = s.n.GetKeyManager().VerifyRemoteVerify(request.GetVerifyData(), request.GetPeerId())
 	if err != nil {
-		res.Error = err.Error()
-		return res, err
+		return nil, keyStatus(err)
 	}
 	return res, nil
 }
@@ -411,7 +529,7 @@ func (s *TrustedService) GetRequestKeyData(ctx context.Context, request *trusted
 	res := new(trusted.GetRequestKeyDataResponse)
 	res.RequestKeyData, err = s.n.GetKeyManager().GetRequestKeyData(request.GetPeerId())
 	if err != nil {
-		return nil, err
+		return nil, keyStatus(err)
 	}
 	return res, nil
 }
@@ -421,8 +539,7 @@ func (s *TrustedService) VerifyRequestKeyData(ctx context.Context, request *trus
 	res := new(trusted.VerifyRequestKeyDataResponse)
 	err = s.n.GetKeyManager().VerifyRequestKeyData(request.GetRequestKeyData(), request.GetPeerId())
 	if err != nil {
-		res.Error = err.Error()
-		return res, err
+		return nil, keyStatus(err)
 	}
 	return res, nil
 }
@@ -432,7 +549,7 @@ func (s *TrustedService) GetResponseKeyData(ctx context.Context, request *truste
 	res := new(trusted.GetResponseKeyDataResponse)
 	res.ResponseKeyData, err = s.n.GetKeyManager().GetResponseKeyData(request.GetPeerId())
 	if err != nil {
-		return nil, err
+		return nil, keyStatus(err)
 	}
 	return res, nil
 }
@@ -442,8 +559,7 @@ func (s *TrustedService) VerifyResponseKey(ctx context.Context, request *trusted
 	res := new(trusted.VerifyResponseKeyResponse)
 	err = s.n.GetKeyManager().VerifyResponseKey(request.GetResponseKeyData(), request.GetPeerId())
 	if err != nil {
-		res.Error = err.Error()
-		return res, err
+		return nil, keyStatus(err)
 	}
 	return res, nil
 }
@@ -470,37 +586,142 @@ func (s *TrustedService) FillBlock(ctx context.Context, req *trusted.FillBlockRe
 }
 
 func (s *TrustedService) CommittedBlockVerify(ctx context.Context, request *trusted.CommittedBlockVerifyRequest) (*trusted.CommittedBlockVerifyResponse, error) {
-	res := new(trusted.CommittedBlockVerifyResponse)
 	block := &types.Block{}
-	err := rlp.DecodeBytes(request.BlockData, block)
-	if err != nil {
-		log.WithField("err", err).Error("rlp decode block data failed")
-		return res, nil
+	if err := rlp.DecodeBytes(request.BlockData, block); err != nil {
+		log.WithField("err", err).Warn("rlp decode block data failed")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid block data: %v", err)
 	}
 	s.blockFiller.VerifyBlock(block)
-	return res, nil
+	return new(trusted.CommittedBlockVerifyResponse), nil
 }
 
-func RegisterService(server *grpc.Server, n *node.Node, nodeconfig config.NodeConfig) {
+func RegisterService(server *grpc.Server, n *node.Node, nodeconfig config.NodeConfig, quit <-chan struct{}) *TrustedService {
 	s := new(TrustedService)
 	s.n = n
+	s.quit = quit
+	s.txs = newTxStream(n.TxPool(), quit)
+	s.owners = newOwnerAuth()
 	s.blockFiller = blockfill.NewBlockFiller(nodeconfig.NodeDir)
 	trusted.RegisterTrustedServiceServer(server, s)
+	return s
+}
+
+// TrustedServer serves the trusted service and the health service over grpc,
+// and the REST/JSON gateway and the eth JSON-RPC server if configured.
+type TrustedServer struct {
+	server  *grpc.Server
+	health  *health.Server
+	gateway *gateway
+	rpc     *rpcServer
+	quit    chan struct{}
+	errc    chan error
 }
 
-func StartTrustedService(n *node.Node, nodeconfig config.NodeConfig) {
+// StartTrustedService listens on the grpc port and serves in the background.
+func StartTrustedService(n *node.Node, nodeconfig config.NodeConfig) (*TrustedServer, error) {
+	tlsConfig, err := serverTLSConfig(nodeconfig.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("load tls config failed: %v", err)
+	}
+	var auth *authenticator
+	if nodeconfig.Auth.Enabled {
+		if auth, err = newAuthenticator(nodeconfig.Auth); err != nil {
+			return nil, fmt.Errorf("invalid auth config: %v", err)
+		}
+	} else {
+		log.Warn("authentication disabled, every caller may use every method")
+	}
+	if privacyMode {
+		log.Info("privacy mode, global pool views are disabled")
+	}
+	opts := serverOptions(tlsConfig, auth)
 	listenAddr := fmt.Sprintf(":%d", nodeconfig.GrpcPort)
 	lis, err := net.Listen("tcp", listenAddr)
 	if err != nil {
-		fmt.Printf("failed to listen: %v", err)
-		return
+		return nil, fmt.Errorf("failed to listen: %v", err)
+	}
+	s := &TrustedServer{
+		server: grpc.NewServer(opts...),
+		quit:   make(chan struct{}),
+		errc:   make(chan error, 1),
 	}
-	s := grpc.NewServer()
-	RegisterService(s, n, nodeconfig)
+	service := RegisterService(s.server, n, nodeconfig, s.quit)
+	RegisterAdminService(s.server, n)
+	s.health = registerHealth(s.server, n, s.quit)
+
+	if nodeconfig.HTTPPort != 0 {
+		if s.gateway, err = startGateway(s.server, nodeconfig.HTTPPort, tlsConfig); err != nil {
+			lis.Close()
+			return nil, err
+		}
+	}
+	if nodeconfig.RPCPort != 0 {
+		if s.rpc, err = startRPC(rpcAPIs(service), auth, nodeconfig.RPCPort, nodeconfig.RPCOrigins, tlsConfig); err != nil {
+			if s.gateway != nil {
+				s.gateway.close()
+			}
+			lis.Close()
+			return nil, err
+		}
+	}
+	go func() {
+		s.errc <- s.server.Serve(lis)
+	}()
+	log.WithField("addr", lis.Addr().String()).Info("trusted service started")
+	return s, nil
+}
+
+// serverOptions returns the transport security and the authentication of the
+// configured server.
+func serverOptions(tlsConfig *tls.Config, auth *authenticator) []grpc.ServerOption {
+	var opts []grpc.ServerOption
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	if auth != nil {
+		opts = append(opts, grpc.ChainUnaryInterceptor(auth.unaryInterceptor), grpc.ChainStreamInterceptor(auth.streamInterceptor))
+	}
+	if privacyMode {
+		opts = append(opts, grpc.ChainUnaryInterceptor(privacyUnaryInterceptor), grpc.ChainStreamInterceptor(privacyStreamInterceptor))
+	}
+	return opts
+}
 
-	err = s.Serve(lis)
-	if err != nil {
-		fmt.Printf("failed to serve: %v", err)
-		return
+// Err returns a channel which receives the error if serving fails.
+func (s *TrustedServer) Err() <-chan error {
+	return s.errc
+}
+
+// Stop reports the service as not serving, so no new requests are routed to
+// it, and waits for the requests in flight. When ctx ends first they are
+// cancelled.
+func (s *TrustedServer) Stop(ctx context.Context) {
+	close(s.quit)
+	s.health.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		if s.gateway != nil {
+			s.gateway.shutdown(ctx)
+		}
+		if s.rpc != nil {
+			s.rpc.shutdown(ctx)
+		}
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		log.Warn("requests in flight not finished, cancel them")
+		if s.gateway != nil {
+			s.gateway.close()
+		}
+		if s.rpc != nil {
+			s.rpc.close()
+		}
+		s.server.Stop()
+		<-stopped
 	}
+	log.Info("trusted service stopped")
 }
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"github.com/trusted-defi/trusted-engine/config"
+	"github.com/trusted-defi/trusted-engine/ratls"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"os"
+)
+
+// serverTLSConfig returns the TLS config of the configured certificate, nil to
+// serve plaintext. An attested certificate is generated in the enclave instead
+// of loaded. With a client CA, client certificates are verified if presented,
+// callers without one may still use tokens.
+func serverTLSConfig(conf config.TLSConfig) (*tls.Config, error) {
+	var tlsConfig *tls.Config
+	switch {
+	case conf.Attested && conf.Cert != "":
+		return nil, errors.New("attested tls doesn't use a certificate file")
+	case conf.Attested:
+		var err error
+		if tlsConfig, err = ratls.ServerTLSConfig(); err != nil {
+			return nil, fmt.Errorf("attested certificate: %w", err)
+		}
+	case conf.Cert != "":
+		cert, err := tls.LoadX509KeyPair(conf.Cert, conf.Key)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}
+	default:
+		if conf.ClientCA != "" {
+			return nil, errors.New("client ca needs a server certificate")
+		}
+		return nil, nil
+	}
+	if conf.ClientCA != "" {
+		pem, err := os.ReadFile(conf.ClientCA)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no certificate in client ca file")
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return tlsConfig, nil
+}
+
+// selfCredentials returns the credentials of an in-process client of the
+// server, it trusts exactly the certificate the server presents.
+func selfCredentials(serverConfig *tls.Config) credentials.TransportCredentials {
+	if serverConfig == nil {
+		return insecure.NewCredentials()
+	}
+	own := serverConfig.Certificates[0].Certificate[0]
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// The server name isn't checked, the certificate is compared as a whole
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 || !bytes.Equal(rawCerts[0], own) {
+				return errors.New("not the certificate of this server")
+			}
+			return nil
+		},
+	})
+}
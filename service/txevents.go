@@ -0,0 +1,161 @@
+package service
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/trusted-defi/trusted-engine/core/mempool"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// txEventsBuffer is how many pool updates a lifecycle subscriber may fall
+// behind before it is dropped, the pool doesn't wait for subscribers.
+const txEventsBuffer = 256
+
+var txEventKinds = map[mempool.TxEventKind]trusted.TxEventKind{
+	mempool.TxQueued:     trusted.TxEventKind_TX_EVENT_QUEUED,
+	mempool.TxPromoted:   trusted.TxEventKind_TX_EVENT_PROMOTED,
+	mempool.TxDemoted:    trusted.TxEventKind_TX_EVENT_DEMOTED,
+	mempool.TxReplaced:   trusted.TxEventKind_TX_EVENT_REPLACED,
+	mempool.TxDropped:    trusted.TxEventKind_TX_EVENT_DROPPED,
+	mempool.TxReinjected: trusted.TxEventKind_TX_EVENT_REINJECTED,
+	mempool.TxIncluded:   trusted.TxEventKind_TX_EVENT_INCLUDED,
+	mempool.TxReorged:    trusted.TxEventKind_TX_EVENT_REORGED,
+}
+
+var txDropReasons = map[mempool.TxDropReason]trusted.TxDropReason{
+	mempool.DropNone:         trusted.TxDropReason_TX_DROP_NONE,
+	mempool.DropUnderpriced:  trusted.TxDropReason_TX_DROP_UNDERPRICED,
+	mempool.DropBelowPrice:   trusted.TxDropReason_TX_DROP_BELOW_PRICE,
+	mempool.DropLifetime:     trusted.TxDropReason_TX_DROP_LIFETIME,
+	mempool.DropNonceTooLow:  trusted.TxDropReason_TX_DROP_NONCE_TOO_LOW,
+	mempool.DropUnpayable:    trusted.TxDropReason_TX_DROP_UNPAYABLE,
+	mempool.DropAccountLimit: trusted.TxDropReason_TX_DROP_ACCOUNT_LIMIT,
+	mempool.DropPendingLimit: trusted.TxDropReason_TX_DROP_PENDING_LIMIT,
+	mempool.DropQueueLimit:   trusted.TxDropReason_TX_DROP_QUEUE_LIMIT,
+	mempool.DropOutbid:       trusted.TxDropReason_TX_DROP_OUTBID,
+	mempool.DropResync:       trusted.TxDropReason_TX_DROP_RESYNC,
+	mempool.DropReplaced:     trusted.TxDropReason_TX_DROP_REPLACED,
+}
+
+// txEventFilter selects the lifecycle events of a subscription, empty sets
+// select any.
+type txEventFilter struct {
+	senders map[common.Address]bool
+	kinds   map[trusted.TxEventKind]bool
+}
+
+func newTxEventFilter(req *trusted.SubscribeTxEventsRequest) (*txEventFilter, error) {
+	f := &txEventFilter{
+		senders: make(map[common.Address]bool),
+		kinds:   make(map[trusted.TxEventKind]bool),
+	}
+	for _, addr := range req.Senders {
+		if len(addr) != common.AddressLength {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sender %x", addr)
+		}
+		f.senders[common.BytesToAddress(addr)] = true
+	}
+	for _, kind := range req.Kinds {
+		if _, ok := trusted.TxEventKind_name[int32(kind)]; !ok || kind == trusted.TxEventKind_TX_EVENT_UNKNOWN {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown event kind %v", kind)
+		}
+		f.kinds[kind] = true
+	}
+	return f, nil
+}
+
+// filter returns the selected events of a pool update, nil if none.
+func (f *txEventFilter) filter(ev mempool.TxLifecycleEvent) *trusted.SubscribeTxEventsResponse {
+	var res *trusted.SubscribeTxEventsResponse
+	for _, e := range ev.Events {
+		kind := txEventKinds[e.Kind]
+		if (len(f.senders) > 0 && !f.senders[e.Sender]) || (len(f.kinds) > 0 && !f.kinds[kind]) {
+			continue
+		}
+		event := &trusted.TxEvent{
+			Kind:   kind,
+			Hash:   e.Tx.Hash().Bytes(),
+			Sender: e.Sender.Bytes(),
+			Nonce:  e.Tx.Nonce(),
+			Reason: txDropReasons[e.Reason],
+		}
+		if e.ReplacedBy != nil {
+			event.ReplacedBy = e.ReplacedBy.Hash().Bytes()
+		}
+		if e.Kind == mempool.TxIncluded || e.Kind == mempool.TxReorged {
+			event.BlockHash = e.BlockHash.Bytes()
+			event.BlockNumber = e.BlockNumber
+		}
+		if res == nil {
+			res = new(trusted.SubscribeTxEventsResponse)
+		}
+		res.Events = append(res.Events, event)
+	}
+	return res
+}
+
+// queueTxEvents subscribes to the lifecycle events of the pool and queues them
+// apart from sending, so a slow subscriber is dropped instead of holding up the
+// pool. dropped is closed when the queue overflowed, the subscription ends when
+// done is closed.
+func queueTxEvents(pool *mempool.TxPool, done <-chan struct{}) (queue <-chan mempool.TxLifecycleEvent, dropped <-chan struct{}) {
+	var (
+		events   = make(chan mempool.TxLifecycleEvent, 16)
+		sub      = pool.SubscribeTxLifecycleEvent(events)
+		queued   = make(chan mempool.TxLifecycleEvent, txEventsBuffer)
+		overflow = make(chan struct{})
+	)
+	go func() {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case ev := <-events:
+				select {
+				case queued <- ev:
+				default:
+					close(overflow)
+					return
+				}
+			case <-sub.Err():
+				return
+			case <-done:
+				return
+			}
+		}
+	}()
+	return queued, overflow
+}
+
+func (s *TrustedService) SubscribeTxEvents(req *trusted.SubscribeTxEventsRequest, server trusted.TrustedService_SubscribeTxEventsServer) error {
+	filter, err := newTxEventFilter(req)
+	if err != nil {
+		return err
+	}
+	done := make(chan struct{})
+	defer close(done)
+	events, dropped := queueTxEvents(s.n.TxPool(), done)
+
+	// Headers tell the caller the subscription is established, the gateway
+	// waits for them before it opens the event stream
+	if err := server.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case ev := <-events:
+			if res := filter.filter(ev); res != nil {
+				if err := server.Send(res); err != nil {
+					return err
+				}
+			}
+		case <-dropped:
+			return errSubscriberTooSlow
+		case <-server.Context().Done():
+			return nil
+		case <-s.quit:
+			return nil
+		}
+	}
+}
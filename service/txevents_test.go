@@ -0,0 +1,64 @@
+package service
+
+import (
+	"bytes"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/trusted-defi/trusted-engine/core/mempool"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestTxEventFilter(t *testing.T) {
+	var (
+		alice = common.HexToAddress("0xaa")
+		bob   = common.HexToAddress("0xbb")
+		tx    = types.NewTx(&types.LegacyTx{Nonce: 3})
+		by    = types.NewTx(&types.LegacyTx{Nonce: 3, GasPrice: common.Big1})
+	)
+	ev := mempool.TxLifecycleEvent{Events: []mempool.TxEvent{
+		{Kind: mempool.TxReplaced, Tx: tx, Sender: alice, ReplacedBy: by},
+		{Kind: mempool.TxDropped, Tx: by, Sender: alice, Reason: mempool.DropLifetime},
+		{Kind: mempool.TxQueued, Tx: tx, Sender: bob},
+	}}
+	f, err := newTxEventFilter(&trusted.SubscribeTxEventsRequest{
+		Senders: [][]byte{alice.Bytes()},
+		Kinds:   []trusted.TxEventKind{trusted.TxEventKind_TX_EVENT_REPLACED, trusted.TxEventKind_TX_EVENT_DROPPED},
+	})
+	if err != nil {
+		t.Fatalf("filter failed: %v", err)
+	}
+	res := f.filter(ev)
+	if res == nil || len(res.Events) != 2 {
+		t.Fatalf("event count mismatch: %v", res)
+	}
+	replaced, dropped := res.Events[0], res.Events[1]
+	if replaced.Kind != trusted.TxEventKind_TX_EVENT_REPLACED || !bytes.Equal(replaced.Hash, tx.Hash().Bytes()) ||
+		!bytes.Equal(replaced.ReplacedBy, by.Hash().Bytes()) || !bytes.Equal(replaced.Sender, alice.Bytes()) || replaced.Nonce != 3 {
+		t.Errorf("replacement mismatch: %v", replaced)
+	}
+	if dropped.Kind != trusted.TxEventKind_TX_EVENT_DROPPED || dropped.Reason != trusted.TxDropReason_TX_DROP_LIFETIME || dropped.ReplacedBy != nil {
+		t.Errorf("drop mismatch: %v", dropped)
+	}
+	if res := f.filter(mempool.TxLifecycleEvent{Events: ev.Events[2:]}); res != nil {
+		t.Errorf("unselected events sent: %v", res)
+	}
+	for _, req := range []*trusted.SubscribeTxEventsRequest{{Senders: [][]byte{{1}}}, {Kinds: []trusted.TxEventKind{0}}, {Kinds: []trusted.TxEventKind{42}}} {
+		if _, err := newTxEventFilter(req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("invalid filter %v accepted: %v", req, err)
+		}
+	}
+	// Every pool kind and reason has a proto value
+	for kind := mempool.TxQueued; kind <= mempool.TxReorged; kind++ {
+		if _, ok := txEventKinds[kind]; !ok {
+			t.Errorf("event kind %v not mapped", kind)
+		}
+	}
+	for reason := mempool.DropNone; reason <= mempool.DropReplaced; reason++ {
+		if _, ok := txDropReasons[reason]; !ok {
+			t.Errorf("drop reason %v not mapped", reason)
+		}
+	}
+}
@@ -0,0 +1,333 @@
+package service
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// txReplaySize is the number of new transactions kept to resume
+	// subscriptions after a reconnect.
+	txReplaySize = 4096
+
+	// txSubscriberBuffer is how many transactions a subscriber may fall behind
+	// before it is dropped, it can resume after its last sequence then.
+	txSubscriberBuffer = 1024
+
+	defaultMaxBatch = 256
+	maxBatchLimit   = 4096
+)
+
+var errSubscriberTooSlow = status.Error(codes.ResourceExhausted, "subscriber fell behind, resume after the last sequence")
+
+// txSource is the feed of new pool transactions.
+type txSource interface {
+	SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscription
+}
+
+// seqTx is a new pool transaction and its sequence number.
+type seqTx struct {
+	seq uint64
+	tx  *types.Transaction
+}
+
+// txStream numbers the new transactions of the pool and passes them to the
+// subscribers, keeping the latest ones to replay to resuming subscribers.
+// Sequences start at the startup time in nanoseconds, so the ones of an
+// earlier run are out of range instead of resuming at other transactions.
+type txStream struct {
+	mu     sync.Mutex
+	next   uint64  // Sequence of the next transaction
+	replay []seqTx // Latest transactions, at most txReplaySize
+	subs   map[*txSubscription]struct{}
+}
+
+// txSubscription receives the new transactions of a txStream.
+type txSubscription struct {
+	stream  *txStream
+	ch      chan seqTx
+	dropped chan struct{} // Closed when the subscriber fell behind
+}
+
+func newTxStream(source txSource, quit <-chan struct{}) *txStream {
+	s := &txStream{
+		next: uint64(time.Now().UnixNano()),
+		subs: make(map[*txSubscription]struct{}),
+	}
+	events := make(chan core.NewTxsEvent, 16)
+	sub := source.SubscribeNewTxsEvent(events)
+	go s.loop(events, sub, quit)
+	return s
+}
+
+func (s *txStream) loop(events chan core.NewTxsEvent, sub event.Subscription, quit <-chan struct{}) {
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case event := <-events:
+			s.publish(event.Txs)
+		case err := <-sub.Err():
+			if err != nil {
+				log.WithField("err", err).Error("new transaction subscription failed")
+			}
+			return
+		case <-quit:
+			return
+		}
+	}
+}
+
+// publish numbers the transactions and passes them on, subscribers which fell
+// behind are dropped instead of waiting for them.
+func (s *txStream) publish(txs []*types.Transaction) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, tx := range txs {
+		stx := seqTx{seq: s.next, tx: tx}
+		s.next++
+		s.replay = append(s.replay, stx)
+		if len(s.replay) > txReplaySize {
+			s.replay = s.replay[len(s.replay)-txReplaySize:]
+		}
+		for sub := range s.subs {
+			select {
+			case sub.ch <- stx:
+			default:
+				close(sub.dropped)
+				delete(s.subs, sub)
+			}
+		}
+	}
+}
+
+// subscribe returns a subscription to the new transactions, and the buffered
+// ones after resumeAfter if it isn't 0. A sequence no longer buffered, or not
+// yet assigned, is out of range.
+func (s *txStream) subscribe(resumeAfter uint64) (*txSubscription, []seqTx, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var replay []seqTx
+	if resumeAfter != 0 {
+		first := s.next
+		if len(s.replay) > 0 {
+			first = s.replay[0].seq
+		}
+		if resumeAfter+1 < first || resumeAfter >= s.next {
+			return nil, nil, status.Errorf(codes.OutOfRange, "sequence %d not in replay buffer", resumeAfter)
+		}
+		i := sort.Search(len(s.replay), func(i int) bool { return s.replay[i].seq > resumeAfter })
+		replay = append(replay, s.replay[i:]...)
+	}
+	sub := &txSubscription{
+		stream:  s,
+		ch:      make(chan seqTx, txSubscriberBuffer),
+		dropped: make(chan struct{}),
+	}
+	s.subs[sub] = struct{}{}
+	return sub, replay, nil
+}
+
+func (sub *txSubscription) unsubscribe() {
+	sub.stream.mu.Lock()
+	defer sub.stream.mu.Unlock()
+	delete(sub.stream.subs, sub)
+}
+
+// txFilter selects the transactions of a subscription, empty sets select any.
+type txFilter struct {
+	senders    map[common.Address]bool
+	recipients map[common.Address]bool
+	txTypes    map[uint8]bool
+	minTip     *big.Int
+}
+
+func newTxFilter(req *trusted.SubscribeNewTxRequest) (*txFilter, error) {
+	f := &txFilter{
+		senders:    make(map[common.Address]bool),
+		recipients: make(map[common.Address]bool),
+		txTypes:    make(map[uint8]bool),
+	}
+	for _, addr := range req.Senders {
+		if len(addr) != common.AddressLength {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sender %x", addr)
+		}
+		f.senders[common.BytesToAddress(addr)] = true
+	}
+	for _, addr := range req.Recipients {
+		if len(addr) != common.AddressLength {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid recipient %x", addr)
+		}
+		f.recipients[common.BytesToAddress(addr)] = true
+	}
+	for _, txType := range req.TxTypes {
+		if txType > 0xff {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tx type %d", txType)
+		}
+		f.txTypes[uint8(txType)] = true
+	}
+	if len(req.MinTip) > 0 {
+		f.minTip = toBigInt(req.MinTip)
+	}
+	return f, nil
+}
+
+// match reports whether the filter selects the transaction, with tips taken
+// at the base fee.
+func (f *txFilter) match(tx *types.Transaction, baseFee *big.Int) bool {
+	if len(f.txTypes) > 0 && !f.txTypes[tx.Type()] {
+		return false
+	}
+	if len(f.recipients) > 0 && (tx.To() == nil || !f.recipients[*tx.To()]) {
+		return false
+	}
+	if f.minTip != nil && tx.EffectiveGasTipIntCmp(f.minTip, baseFee) < 0 {
+		return false
+	}
+	if len(f.senders) > 0 {
+		signer := types.LatestSignerForChainID(tx.ChainId())
+		if !tx.Protected() {
+			signer = types.HomesteadSigner{}
+		}
+		from, err := types.Sender(signer, tx)
+		if err != nil || !f.senders[from] {
+			return false
+		}
+	}
+	return true
+}
+
+// txBatcher sends the transactions of a subscription in batches of at most
+// maxBatch. With a flush interval a batch waits up to it to fill, else it is
+// sent once the received transactions are drained.
+type txBatcher struct {
+	filter   *txFilter
+	maxBatch int
+	interval time.Duration
+
+	baseFee func() *big.Int
+	encode  func(tx *types.Transaction) ([]byte, error)
+	send    func(res *trusted.SubscribeNewTxResponse) error
+}
+
+func newTxBatcher(req *trusted.SubscribeNewTxRequest) (*txBatcher, error) {
+	filter, err := newTxFilter(req)
+	if err != nil {
+		return nil, err
+	}
+	b := &txBatcher{filter: filter, maxBatch: int(req.MaxBatch)}
+	switch {
+	case b.maxBatch == 0:
+		b.maxBatch = defaultMaxBatch
+	case b.maxBatch > maxBatchLimit:
+		b.maxBatch = maxBatchLimit
+	}
+	if req.FlushInterval != nil {
+		if err := req.FlushInterval.CheckValid(); err != nil || req.FlushInterval.AsDuration() < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid flush interval %v", req.FlushInterval.AsDuration())
+		}
+		b.interval = req.FlushInterval.AsDuration()
+	}
+	return b, nil
+}
+
+// run sends the replayed transactions, then the new ones until the context is
+// done, quit is closed or the subscriber fell behind.
+func (b *txBatcher) run(ctx context.Context, quit <-chan struct{}, sub *txSubscription, replay []seqTx) error {
+	var (
+		batch   [][]byte
+		last    uint64
+		baseFee = b.baseFee()
+		timer   = time.NewTimer(0)
+		timeout <-chan time.Time
+	)
+	<-timer.C
+	defer timer.Stop()
+
+	flush := func() error {
+		if timeout != nil && !timer.Stop() {
+			<-timer.C
+		}
+		timeout = nil
+		if len(batch) == 0 {
+			return nil
+		}
+		res := &trusted.SubscribeNewTxResponse{CryptedNewTx: batch, Sequence: last}
+		batch = nil
+		baseFee = b.baseFee()
+		return b.send(res)
+	}
+	add := func(stx seqTx) error {
+		last = stx.seq
+		if !b.filter.match(stx.tx, baseFee) {
+			return nil
+		}
+		data, err := b.encode(stx.tx)
+		if err != nil {
+			log.WithField("err", err).Error("encode new transaction failed")
+			return nil
+		}
+		batch = append(batch, data)
+		if len(batch) >= b.maxBatch {
+			return flush()
+		}
+		return nil
+	}
+	for _, stx := range replay {
+		if err := add(stx); err != nil {
+			return err
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case stx := <-sub.ch:
+			if err := add(stx); err != nil {
+				return err
+			}
+			switch {
+			case b.interval == 0 && len(sub.ch) == 0:
+				if err := flush(); err != nil {
+					return err
+				}
+			case b.interval > 0 && timeout == nil && len(batch) > 0:
+				timer.Reset(b.interval)
+				timeout = timer.C
+			}
+		case <-timeout:
+			timeout = nil
+			if err := flush(); err != nil {
+				return err
+			}
+		case <-sub.dropped:
+			// Send what was received, the subscriber resumes after it
+			for len(sub.ch) > 0 {
+				if err := add(<-sub.ch); err != nil {
+					return err
+				}
+			}
+			if err := flush(); err != nil {
+				return err
+			}
+			return errSubscriberTooSlow
+		case <-ctx.Done():
+			return nil
+		case <-quit:
+			return nil
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"math/big"
+	"testing"
+	"time"
+)
+
+type testTxSource struct {
+	feed event.Feed
+}
+
+func (s *testTxSource) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscription {
+	return s.feed.Subscribe(ch)
+}
+
+func testTxs(n int) []*types.Transaction {
+	txs := make([]*types.Transaction, n)
+	for i := range txs {
+		txs[i] = types.NewTx(&types.LegacyTx{Nonce: uint64(i)})
+	}
+	return txs
+}
+
+func TestTxStreamResume(t *testing.T) {
+	quit := make(chan struct{})
+	defer close(quit)
+	stream := newTxStream(new(testTxSource), quit)
+	first := stream.next
+
+	stream.publish(testTxs(3))
+	sub, replay, err := stream.subscribe(first)
+	if err != nil {
+		t.Fatalf("resume failed: %v", err)
+	}
+	defer sub.unsubscribe()
+	if len(replay) != 2 || replay[0].seq != first+1 || replay[0].tx.Nonce() != 1 || replay[1].seq != first+2 {
+		t.Errorf("replay mismatch: %+v", replay)
+	}
+	if _, replay, err := stream.subscribe(first + 2); err != nil || len(replay) != 0 {
+		t.Errorf("resume at the last sequence failed: %v, replay %d", err, len(replay))
+	}
+	for _, seq := range []uint64{first - 2, first + 3} {
+		if _, _, err := stream.subscribe(seq); status.Code(err) != codes.OutOfRange {
+			t.Errorf("resume after %d: have %v, want out of range", seq, err)
+		}
+	}
+	stream.publish(testTxs(txReplaySize))
+	if _, _, err := stream.subscribe(first); status.Code(err) != codes.OutOfRange {
+		t.Errorf("resume after evicted sequence: have %v, want out of range", err)
+	}
+	if len(stream.replay) != txReplaySize {
+		t.Errorf("replay buffer not bounded: %d", len(stream.replay))
+	}
+	// The subscriber didn't read and fell behind
+	select {
+	case <-sub.dropped:
+	default:
+		t.Errorf("slow subscriber not dropped")
+	}
+}
+
+func TestTxFilter(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.HexToAddress("0xbb")
+	signer := types.LatestSignerForChainID(big.NewInt(1))
+	dynamic := types.MustSignNewTx(key, signer, &types.DynamicFeeTx{ChainID: big.NewInt(1), To: &to, GasTipCap: big.NewInt(5), GasFeeCap: big.NewInt(20)})
+	legacy := types.MustSignNewTx(key, types.HomesteadSigner{}, &types.LegacyTx{GasPrice: big.NewInt(10)})
+
+	tests := []struct {
+		req     *trusted.SubscribeNewTxRequest
+		dynamic bool
+		legacy  bool
+	}{
+		{&trusted.SubscribeNewTxRequest{}, true, true},
+		{&trusted.SubscribeNewTxRequest{Senders: [][]byte{sender.Bytes()}}, true, true},
+		{&trusted.SubscribeNewTxRequest{Senders: [][]byte{to.Bytes()}}, false, false},
+		{&trusted.SubscribeNewTxRequest{Recipients: [][]byte{to.Bytes()}}, true, false},
+		{&trusted.SubscribeNewTxRequest{TxTypes: []uint32{types.LegacyTxType}}, false, true},
+		// Tips at base fee 10: dynamic 5, legacy 0
+		{&trusted.SubscribeNewTxRequest{MinTip: []byte{5}}, true, false},
+		{&trusted.SubscribeNewTxRequest{MinTip: []byte{6}}, false, false},
+	}
+	for i, tt := range tests {
+		f, err := newTxFilter(tt.req)
+		if err != nil {
+			t.Fatalf("test %d: filter failed: %v", i, err)
+		}
+		if have := f.match(dynamic, big.NewInt(10)); have != tt.dynamic {
+			t.Errorf("test %d: dynamic fee tx match %v, want %v", i, have, tt.dynamic)
+		}
+		if have := f.match(legacy, big.NewInt(10)); have != tt.legacy {
+			t.Errorf("test %d: legacy tx match %v, want %v", i, have, tt.legacy)
+		}
+	}
+	for _, req := range []*trusted.SubscribeNewTxRequest{{Senders: [][]byte{{1}}}, {TxTypes: []uint32{256}}} {
+		if _, err := newTxFilter(req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("invalid filter %v accepted: %v", req, err)
+		}
+	}
+}
+
+func TestTxBatcher(t *testing.T) {
+	quit := make(chan struct{})
+	defer close(quit)
+	stream := newTxStream(new(testTxSource), quit)
+	first := stream.next
+	stream.publish(testTxs(5))
+
+	batcher, err := newTxBatcher(&trusted.SubscribeNewTxRequest{MaxBatch: 2, FlushInterval: durationpb.New(time.Hour)})
+	if err != nil {
+		t.Fatalf("batcher failed: %v", err)
+	}
+	sent := make(chan *trusted.SubscribeNewTxResponse, 16)
+	batcher.baseFee = func() *big.Int { return nil }
+	batcher.encode = func(tx *types.Transaction) ([]byte, error) { return tx.MarshalBinary() }
+	batcher.send = func(res *trusted.SubscribeNewTxResponse) error {
+		sent <- res
+		return nil
+	}
+	sub, replay, err := stream.subscribe(first)
+	if err != nil {
+		t.Fatalf("resume failed: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- batcher.run(ctx, quit, sub, replay) }()
+
+	// The four replayed transactions are sent right away in full batches
+	for _, seq := range []uint64{first + 2, first + 4} {
+		if res := <-sent; len(res.CryptedNewTx) != 2 || res.Sequence != seq {
+			t.Errorf("replay batch mismatch: %d txs, sequence %d, want %d", len(res.CryptedNewTx), res.Sequence, seq)
+		}
+	}
+	// A single new one waits for the flush interval, a second fills the batch
+	stream.publish(testTxs(1))
+	select {
+	case res := <-sent:
+		t.Errorf("partial batch sent: %d txs", len(res.CryptedNewTx))
+	case <-time.After(50 * time.Millisecond):
+	}
+	stream.publish(testTxs(1))
+	if res := <-sent; len(res.CryptedNewTx) != 2 || res.Sequence != first+6 {
+		t.Errorf("batch mismatch: %d txs, sequence %d", len(res.CryptedNewTx), res.Sequence)
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("run failed: %v", err)
+	}
+	if _, err := newTxBatcher(&trusted.SubscribeNewTxRequest{FlushInterval: durationpb.New(-time.Second)}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("negative flush interval accepted: %v", err)
+	}
+}
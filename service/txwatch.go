@@ -0,0 +1,305 @@
+package service
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/trusted-defi/trusted-engine/core/chainclient"
+	"github.com/trusted-defi/trusted-engine/core/mempool"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxWatchHashes      = 1024
+	defaultWatchTimeout = 10 * time.Minute
+	maxWatchTimeout     = time.Hour
+)
+
+var errWatcherTooSlow = status.Error(codes.ResourceExhausted, "watcher fell behind, watch again")
+
+// txStatusSource is what a watch reads the current status of transactions from.
+type txStatusSource interface {
+	Status(hashes []common.Hash) []mempool.TxStatus
+	Get(hash common.Hash) *types.Transaction
+	Record(hash common.Hash) *mempool.TxRecord
+}
+
+// txWatchState is the last reported state of a watched transaction.
+type txWatchState struct {
+	status      trusted.TxWatchStatus
+	final       bool
+	blockHash   common.Hash
+	blockNumber uint64
+	reason      mempool.TxDropReason
+	replacedBy  common.Hash
+}
+
+// txWatch follows the status of a set of transactions through the pool
+// lifecycle events, reporting only the changes. Included transactions become
+// final once the head is the given number of confirmations above their block.
+// The pool states are only reported for the transactions of the owner.
+type txWatch struct {
+	hashes        []common.Hash
+	txs           map[common.Hash]*txWatchState
+	confirmations uint64
+	owner         *common.Address // Signer of the watch, nil if unsigned
+	head          uint64
+	open          int // Transactions not final yet
+}
+
+func newTxWatch(hashes []common.Hash, confirmations uint64, owner *common.Address) *txWatch {
+	w := &txWatch{
+		txs:           make(map[common.Hash]*txWatchState),
+		confirmations: confirmations,
+		owner:         owner,
+	}
+	for _, hash := range hashes {
+		if w.txs[hash] == nil {
+			w.txs[hash] = new(txWatchState)
+			w.hashes = append(w.hashes, hash)
+		}
+	}
+	w.open = len(w.hashes)
+	return w
+}
+
+// load reads the current status of the transactions, returning an event for
+// each of them.
+func (w *txWatch) load(src txStatusSource) []*trusted.TxWatchEvent {
+	events := make([]*trusted.TxWatchEvent, 0, len(w.hashes))
+	for i, st := range src.Status(w.hashes) {
+		hash := w.hashes[i]
+		next := *w.txs[hash]
+		if !showsPoolState(src, w.owner, hash, st) {
+			st = mempool.TxStatusUnknown
+		}
+		switch st {
+		case mempool.TxStatusQueued:
+			next.status = trusted.TxWatchStatus_TX_WATCH_QUEUED
+		case mempool.TxStatusPending:
+			next.status = trusted.TxWatchStatus_TX_WATCH_PENDING
+		case mempool.TxStatusIncluded, mempool.TxStatusDropped:
+			rec := src.Record(hash)
+			switch {
+			case rec == nil:
+				// Evicted from the history in between, nothing to tell
+			case rec.Status == mempool.TxStatusIncluded:
+				next.status = trusted.TxWatchStatus_TX_WATCH_INCLUDED
+				next.blockHash, next.blockNumber = rec.BlockHash, rec.BlockNumber
+				next.final = w.confirmed(rec.BlockNumber)
+			case rec.Reason == mempool.DropReplaced:
+				next.status, next.final = trusted.TxWatchStatus_TX_WATCH_REPLACED, true
+			default:
+				next.status, next.final = trusted.TxWatchStatus_TX_WATCH_DROPPED, true
+				next.reason = rec.Reason
+			}
+		}
+		events = append(events, w.update(hash, next))
+	}
+	return events
+}
+
+// apply folds a pool lifecycle event into the watch, returning the change of a
+// watched transaction or nil if there is none.
+func (w *txWatch) apply(ev mempool.TxEvent) *trusted.TxWatchEvent {
+	hash := ev.Tx.Hash()
+	prev := w.txs[hash]
+	if prev == nil || prev.final {
+		return nil
+	}
+	next := *prev
+	if ev.Kind != mempool.TxIncluded && ev.Kind != mempool.TxReorged && (w.owner == nil || ev.Sender != *w.owner) {
+		return nil
+	}
+	switch ev.Kind {
+	case mempool.TxQueued, mempool.TxDemoted:
+		next.status = trusted.TxWatchStatus_TX_WATCH_QUEUED
+	case mempool.TxPromoted:
+		next.status = trusted.TxWatchStatus_TX_WATCH_PENDING
+	case mempool.TxReplaced:
+		next.status, next.final = trusted.TxWatchStatus_TX_WATCH_REPLACED, true
+		if ev.ReplacedBy != nil {
+			next.replacedBy = ev.ReplacedBy.Hash()
+		}
+	case mempool.TxDropped:
+		next.status, next.final = trusted.TxWatchStatus_TX_WATCH_DROPPED, true
+		next.reason = ev.Reason
+	case mempool.TxIncluded:
+		next.status = trusted.TxWatchStatus_TX_WATCH_INCLUDED
+		next.blockHash, next.blockNumber = ev.BlockHash, ev.BlockNumber
+		next.final = w.confirmed(ev.BlockNumber)
+	case mempool.TxReorged:
+		next.status = trusted.TxWatchStatus_TX_WATCH_REORGED
+		next.blockHash, next.blockNumber = ev.BlockHash, ev.BlockNumber
+	default:
+		return nil
+	}
+	if next == *prev {
+		return nil
+	}
+	return w.update(hash, next)
+}
+
+// setHead moves the head, returning the included transactions it confirmed.
+func (w *txWatch) setHead(number uint64) []*trusted.TxWatchEvent {
+	w.head = number
+	var events []*trusted.TxWatchEvent
+	for _, hash := range w.hashes {
+		st := w.txs[hash]
+		if st.final || st.status != trusted.TxWatchStatus_TX_WATCH_INCLUDED || !w.confirmed(st.blockNumber) {
+			continue
+		}
+		next := *st
+		next.final = true
+		events = append(events, w.update(hash, next))
+	}
+	return events
+}
+
+// confirmed reports whether a block has enough confirmations at the head,
+// without confirmations any block does.
+func (w *txWatch) confirmed(number uint64) bool {
+	return w.confirmations == 0 || w.head >= number+w.confirmations
+}
+
+// done reports whether every watched transaction is final.
+func (w *txWatch) done() bool {
+	return w.open == 0
+}
+
+// update stores the new state of a transaction and returns its event.
+func (w *txWatch) update(hash common.Hash, next txWatchState) *trusted.TxWatchEvent {
+	st := w.txs[hash]
+	if next.final && !st.final {
+		w.open--
+	}
+	*st = next
+
+	event := &trusted.TxWatchEvent{
+		Hash:   hash.Bytes(),
+		Status: st.status,
+		Final:  st.final,
+		Reason: txDropReasons[st.reason],
+	}
+	if st.status == trusted.TxWatchStatus_TX_WATCH_INCLUDED || st.status == trusted.TxWatchStatus_TX_WATCH_REORGED {
+		event.BlockHash = st.blockHash.Bytes()
+		event.BlockNumber = st.blockNumber
+	}
+	if st.replacedBy != (common.Hash{}) {
+		event.ReplacedBy = st.replacedBy.Bytes()
+	}
+	return event
+}
+
+// latestHeads follows the head numbers of the chain keeping only the newest, so
+// a slow watcher doesn't hold up the chain client.
+func latestHeads(chain *chainclient.ChainClient, done <-chan struct{}) <-chan uint64 {
+	heads := make(chan chainclient.ChainHeaderEvent, 16)
+	sub := chain.SubscribeChainHeaderEvent(heads)
+	latest := make(chan uint64, 1)
+	go func() {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case ev := <-heads:
+				select {
+				case <-latest:
+				default:
+				}
+				latest <- ev.Header.Number.Uint64()
+			case <-sub.Err():
+				return
+			case <-done:
+				return
+			}
+		}
+	}()
+	return latest
+}
+
+func (s *TrustedService) WatchTransactions(req *trusted.WatchTransactionsRequest, server trusted.TrustedService_WatchTransactionsServer) error {
+	if len(req.Hashes) == 0 || len(req.Hashes) > maxWatchHashes {
+		return status.Errorf(codes.InvalidArgument, "watch 1 to %d transactions, not %d", maxWatchHashes, len(req.Hashes))
+	}
+	owner, replyKey, err := s.statusOwner("WatchTransactions", req.Owner, req.Auth)
+	if err != nil {
+		return err
+	}
+	send := func(res *trusted.WatchTransactionsResponse) error {
+		if replyKey != nil {
+			crypted, err := sealReply(res, replyKey)
+			if err != nil {
+				return err
+			}
+			res = &trusted.WatchTransactionsResponse{Crypted: crypted}
+		}
+		return server.Send(res)
+	}
+	hashes := make([]common.Hash, len(req.Hashes))
+	for i, hash := range req.Hashes {
+		if len(hash) != common.HashLength {
+			return status.Errorf(codes.InvalidArgument, "invalid hash %x", hash)
+		}
+		hashes[i] = common.BytesToHash(hash)
+	}
+	timeout := defaultWatchTimeout
+	if req.Timeout != nil {
+		if err := req.Timeout.CheckValid(); err != nil || req.Timeout.AsDuration() <= 0 || req.Timeout.AsDuration() > maxWatchTimeout {
+			return status.Errorf(codes.InvalidArgument, "invalid timeout %v, at most %v", req.Timeout.AsDuration(), maxWatchTimeout)
+		}
+		timeout = req.Timeout.AsDuration()
+	}
+
+	// Subscribe before reading the current status so no change falls in
+	// between, changes already covered by it are not reported again
+	done := make(chan struct{})
+	defer close(done)
+	events, dropped := queueTxEvents(s.n.TxPool(), done)
+	heads := latestHeads(s.n.Chain(), done)
+
+	watch := newTxWatch(hashes, uint64(req.Confirmations), owner)
+	if req.Confirmations > 0 {
+		if head, err := s.n.Chain().CurrentHeader(); err == nil {
+			watch.setHead(head.Number.Uint64())
+		}
+	}
+	if err := server.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+	if err := send(&trusted.WatchTransactionsResponse{Events: watch.load(s.n.TxPool())}); err != nil {
+		return err
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for !watch.done() {
+		res := new(trusted.WatchTransactionsResponse)
+		select {
+		case ev := <-events:
+			for _, e := range ev.Events {
+				if event := watch.apply(e); event != nil {
+					res.Events = append(res.Events, event)
+				}
+			}
+		case head := <-heads:
+			res.Events = watch.setHead(head)
+		case <-dropped:
+			return errWatcherTooSlow
+		case <-timer.C:
+			return status.Errorf(codes.DeadlineExceeded, "transactions not final after %v", timeout)
+		case <-server.Context().Done():
+			return nil
+		case <-s.quit:
+			return nil
+		}
+		if len(res.Events) > 0 {
+			if err := send(res); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"bytes"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/trusted-defi/trusted-engine/core/mempool"
+	trusted "github.com/trusted-defi/trusted-engine/protocol/generate/trusted/v1"
+	"math/big"
+	"testing"
+)
+
+type testStatusSource struct {
+	status  map[common.Hash]mempool.TxStatus
+	txs     map[common.Hash]*types.Transaction
+	records map[common.Hash]*mempool.TxRecord
+}
+
+func (s *testStatusSource) Status(hashes []common.Hash) []mempool.TxStatus {
+	status := make([]mempool.TxStatus, len(hashes))
+	for i, hash := range hashes {
+		status[i] = s.status[hash]
+	}
+	return status
+}
+
+func (s *testStatusSource) Get(hash common.Hash) *types.Transaction {
+	return s.txs[hash]
+}
+
+func (s *testStatusSource) Record(hash common.Hash) *mempool.TxRecord {
+	return s.records[hash]
+}
+
+var testOwnerKey, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+
+// signedTx returns a transaction with the nonce and gas price signed by key.
+func signedTx(t *testing.T, nonce uint64, price int64) *types.Transaction {
+	t.Helper()
+	tx, err := types.SignTx(types.NewTx(&types.LegacyTx{Nonce: nonce, GasPrice: big.NewInt(price)}), types.HomesteadSigner{}, testOwnerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func TestTxWatch(t *testing.T) {
+	var (
+		queued   = signedTx(t, 1, 0)
+		replaced = signedTx(t, 2, 0)
+		dropped  = signedTx(t, 3, 0)
+		included = signedTx(t, 4, 0)
+		by       = signedTx(t, 2, 1)
+		block1   = common.HexToHash("0x01")
+		block2   = common.HexToHash("0x02")
+		owner    = crypto.PubkeyToAddress(testOwnerKey.PublicKey)
+	)
+	src := &testStatusSource{
+		status: map[common.Hash]mempool.TxStatus{
+			queued.Hash():   mempool.TxStatusQueued,
+			replaced.Hash(): mempool.TxStatusPending,
+			included.Hash(): mempool.TxStatusIncluded,
+		},
+		txs: map[common.Hash]*types.Transaction{
+			queued.Hash():   queued,
+			replaced.Hash(): replaced,
+		},
+		records: map[common.Hash]*mempool.TxRecord{
+			included.Hash(): {Status: mempool.TxStatusIncluded, BlockHash: block1, BlockNumber: 10},
+		},
+	}
+	watch := newTxWatch([]common.Hash{queued.Hash(), replaced.Hash(), dropped.Hash(), included.Hash(), queued.Hash()}, 2, &owner)
+	watch.setHead(10)
+
+	check := func(event *trusted.TxWatchEvent, tx *types.Transaction, want trusted.TxWatchStatus, final bool) {
+		t.Helper()
+		if event == nil {
+			t.Fatalf("no event for %x, want %v", tx.Hash(), want)
+		}
+		if !bytes.Equal(event.Hash, tx.Hash().Bytes()) || event.Status != want || event.Final != final {
+			t.Errorf("event mismatch: have %v, want %x %v final %v", event, tx.Hash(), want, final)
+		}
+	}
+	// Every transaction is reported once, duplicates are watched once
+	events := watch.load(src)
+	if len(events) != 4 {
+		t.Fatalf("initial event count mismatch: have %d, want 4", len(events))
+	}
+	check(events[0], queued, trusted.TxWatchStatus_TX_WATCH_QUEUED, false)
+	check(events[1], replaced, trusted.TxWatchStatus_TX_WATCH_PENDING, false)
+	check(events[2], dropped, trusted.TxWatchStatus_TX_WATCH_UNKNOWN, false)
+	check(events[3], included, trusted.TxWatchStatus_TX_WATCH_INCLUDED, false)
+	if !bytes.Equal(events[3].BlockHash, block1.Bytes()) || events[3].BlockNumber != 10 {
+		t.Errorf("included block mismatch: %v", events[3])
+	}
+
+	// Only changes are reported, final transactions report no more
+	if event := watch.apply(mempool.TxEvent{Sender: owner, Kind: mempool.TxQueued, Tx: queued}); event != nil {
+		t.Errorf("unchanged status reported: %v", event)
+	}
+	check(watch.apply(mempool.TxEvent{Sender: owner, Kind: mempool.TxPromoted, Tx: queued}), queued, trusted.TxWatchStatus_TX_WATCH_PENDING, false)
+	check(watch.apply(mempool.TxEvent{Sender: owner, Kind: mempool.TxDemoted, Tx: queued}), queued, trusted.TxWatchStatus_TX_WATCH_QUEUED, false)
+	event := watch.apply(mempool.TxEvent{Sender: owner, Kind: mempool.TxReplaced, Tx: replaced, ReplacedBy: by})
+	check(event, replaced, trusted.TxWatchStatus_TX_WATCH_REPLACED, true)
+	if !bytes.Equal(event.ReplacedBy, by.Hash().Bytes()) {
+		t.Errorf("replacement mismatch: have %x, want %x", event.ReplacedBy, by.Hash())
+	}
+	if event := watch.apply(mempool.TxEvent{Sender: owner, Kind: mempool.TxPromoted, Tx: replaced}); event != nil {
+		t.Errorf("final transaction reported: %v", event)
+	}
+	if event := watch.apply(mempool.TxEvent{Sender: owner, Kind: mempool.TxPromoted, Tx: by}); event != nil {
+		t.Errorf("unwatched transaction reported: %v", event)
+	}
+	event = watch.apply(mempool.TxEvent{Sender: owner, Kind: mempool.TxDropped, Tx: dropped, Reason: mempool.DropLifetime})
+	check(event, dropped, trusted.TxWatchStatus_TX_WATCH_DROPPED, true)
+	if event.Reason != trusted.TxDropReason_TX_DROP_LIFETIME {
+		t.Errorf("drop reason mismatch: have %v", event.Reason)
+	}
+
+	// Included transactions come and go with reorgs until confirmed
+	event = watch.apply(mempool.TxEvent{Sender: owner, Kind: mempool.TxReorged, Tx: included, BlockHash: block1, BlockNumber: 10})
+	check(event, included, trusted.TxWatchStatus_TX_WATCH_REORGED, false)
+	check(watch.apply(mempool.TxEvent{Sender: owner, Kind: mempool.TxQueued, Tx: included}), included, trusted.TxWatchStatus_TX_WATCH_QUEUED, false)
+	event = watch.apply(mempool.TxEvent{Sender: owner, Kind: mempool.TxIncluded, Tx: included, BlockHash: block2, BlockNumber: 11})
+	check(event, included, trusted.TxWatchStatus_TX_WATCH_INCLUDED, false)
+	if !bytes.Equal(event.BlockHash, block2.Bytes()) || event.BlockNumber != 11 {
+		t.Errorf("moved block mismatch: %v", event)
+	}
+	if events := watch.setHead(12); len(events) != 0 || watch.done() {
+		t.Errorf("unconfirmed transaction final: %v", events)
+	}
+	check(watch.apply(mempool.TxEvent{Sender: owner, Kind: mempool.TxDropped, Tx: queued, Reason: mempool.DropNonceTooLow}), queued, trusted.TxWatchStatus_TX_WATCH_DROPPED, true)
+	events = watch.setHead(13)
+	if len(events) != 1 {
+		t.Fatalf("confirmed event count mismatch: have %d, want 1", len(events))
+	}
+	check(events[0], included, trusted.TxWatchStatus_TX_WATCH_INCLUDED, true)
+	if !watch.done() {
+		t.Error("watch not done with every transaction final")
+	}
+}
+
+func TestTxWatchLoadFinal(t *testing.T) {
+	var (
+		replaced = common.HexToHash("0x01")
+		dropped  = common.HexToHash("0x02")
+		included = common.HexToHash("0x03")
+		owner    = crypto.PubkeyToAddress(testOwnerKey.PublicKey)
+	)
+	src := &testStatusSource{
+		status: map[common.Hash]mempool.TxStatus{
+			replaced: mempool.TxStatusDropped,
+			dropped:  mempool.TxStatusDropped,
+			included: mempool.TxStatusIncluded,
+		},
+		records: map[common.Hash]*mempool.TxRecord{
+			replaced: {Status: mempool.TxStatusDropped, Reason: mempool.DropReplaced, Sender: owner},
+			dropped:  {Status: mempool.TxStatusDropped, Reason: mempool.DropUnpayable, Sender: owner},
+			included: {Status: mempool.TxStatusIncluded, BlockNumber: 5},
+		},
+	}
+	// Without confirmations included transactions are final right away
+	watch := newTxWatch([]common.Hash{replaced, dropped, included}, 0, &owner)
+	want := []trusted.TxWatchStatus{trusted.TxWatchStatus_TX_WATCH_REPLACED, trusted.TxWatchStatus_TX_WATCH_DROPPED, trusted.TxWatchStatus_TX_WATCH_INCLUDED}
+	for i, event := range watch.load(src) {
+		if event.Status != want[i] || !event.Final {
+			t.Errorf("event %d mismatch: have %v, want final %v", i, event, want[i])
+		}
+	}
+	if !watch.done() {
+		t.Error("watch not done with every transaction final")
+	}
+}
+
+// Tests that the pool states of transactions are only reported to watches
+// signed by their sender, included transactions to any watch.
+func TestTxWatchOwner(t *testing.T) {
+	var (
+		pending  = signedTx(t, 1, 0)
+		dropped  = signedTx(t, 2, 0)
+		included = signedTx(t, 3, 0)
+		owner    = crypto.PubkeyToAddress(testOwnerKey.PublicKey)
+		stranger = common.HexToAddress("0x01")
+	)
+	src := &testStatusSource{
+		status: map[common.Hash]mempool.TxStatus{
+			pending.Hash():  mempool.TxStatusPending,
+			dropped.Hash():  mempool.TxStatusDropped,
+			included.Hash(): mempool.TxStatusIncluded,
+		},
+		txs: map[common.Hash]*types.Transaction{pending.Hash(): pending},
+		records: map[common.Hash]*mempool.TxRecord{
+			dropped.Hash():  {Status: mempool.TxStatusDropped, Reason: mempool.DropLifetime, Sender: owner},
+			included.Hash(): {Status: mempool.TxStatusIncluded, BlockNumber: 5},
+		},
+	}
+	hashes := []common.Hash{pending.Hash(), dropped.Hash(), included.Hash()}
+	for _, tt := range []struct {
+		name  string
+		owner *common.Address
+		shown bool
+	}{
+		{"unsigned", nil, false},
+		{"stranger", &stranger, false},
+		{"owner", &owner, true},
+	} {
+		watch := newTxWatch(hashes, 0, tt.owner)
+		want := []trusted.TxWatchStatus{trusted.TxWatchStatus_TX_WATCH_UNKNOWN, trusted.TxWatchStatus_TX_WATCH_UNKNOWN, trusted.TxWatchStatus_TX_WATCH_INCLUDED}
+		if tt.shown {
+			want[0], want[1] = trusted.TxWatchStatus_TX_WATCH_PENDING, trusted.TxWatchStatus_TX_WATCH_DROPPED
+		}
+		for i, event := range watch.load(src) {
+			if event.Status != want[i] {
+				t.Errorf("%s: event %d status mismatch: have %v, want %v", tt.name, i, event.Status, want[i])
+			}
+		}
+		event := watch.apply(mempool.TxEvent{Kind: mempool.TxReplaced, Tx: pending, Sender: owner, ReplacedBy: signedTx(t, 1, 1)})
+		if (event != nil) != tt.shown || (event != nil && len(event.ReplacedBy) == 0) {
+			t.Errorf("%s: replacement reported %v, want %v", tt.name, event, tt.shown)
+		}
+	}
+}